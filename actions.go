@@ -237,18 +237,25 @@ func (c *Client) GetActionsByUUID(actionReq ActionsRequest) (ActionResults, erro
 		DetailLevel:     actionReq.DetailLevel,
 	}
 
+	urlPath := "/entities/" + actionReq.Uuid + "/actions"
+
+	return c.postActions(urlPath, actionCriteria, CommonReqParams{
+		Headers:         actionReq.Headers,
+		QueryParameters: actionReq.QueryParameters})
+}
+
+// Posts action criteria to the provided actions endpoint
+func (c *Client) postActions(urlPath string, actionCriteria ActionsCriteria, reqParams CommonReqParams) (ActionResults, error) {
+
 	dtoBuf := new(bytes.Buffer)
 	if err := json.NewEncoder(dtoBuf).Encode(actionCriteria); err != nil {
 		return nil, err
 	}
-	urlPath := "/entities/" + actionReq.Uuid + "/actions"
 	reqDTO := RequestOptions{
-		Method: "POST",
-		Path:   urlPath,
-		ReqDTO: dtoBuf,
-		CommonReqParams: CommonReqParams{
-			Headers:         actionReq.Headers,
-			QueryParameters: actionReq.QueryParameters}}
+		Method:          "POST",
+		Path:            urlPath,
+		ReqDTO:          dtoBuf,
+		CommonReqParams: reqParams}
 
 	restResp, err := c.request(reqDTO)
 	if err != nil {
@@ -0,0 +1,261 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Health states of appliance components
+const (
+	HealthNormal   = "NORMAL"
+	HealthMinor    = "MINOR"
+	HealthMajor    = "MAJOR"
+	HealthCritical = "CRITICAL"
+)
+
+// Health states from the least to the most severe
+var healthSeverity = []string{HealthNormal, HealthMinor, HealthMajor, HealthCritical}
+
+// Endpoint exporting the diagnostics of the appliance
+const diagnosticsPath = "/admin/diagnostics"
+
+// License installed on a Turbonomic instance
+type License struct {
+	UUID                string   `json:"uuid"`
+	DisplayName         string   `json:"displayName,omitempty"`
+	Email               string   `json:"email,omitempty"`
+	Edition             string   `json:"edition,omitempty"`
+	ExpirationDate      string   `json:"expirationDate,omitempty"`
+	Expired             bool     `json:"expired"`
+	Features            []string `json:"features,omitempty"`
+	NumLicensedEntities int      `json:"numLicensedEntities"`
+	CountedEntity       string   `json:"countedEntity,omitempty"`
+	Filename            string   `json:"filename,omitempty"`
+	ErrorReasons        []string `json:"errorReasons,omitempty"`
+}
+
+// Combined state of the licenses installed on a Turbonomic instance
+type LicenseSummary struct {
+	ExpirationDate      string   `json:"expirationDate,omitempty"`
+	IsExpired           bool     `json:"isExpired"`
+	IsOverLimit         bool     `json:"isOverLimit"`
+	Features            []string `json:"features,omitempty"`
+	NumLicensedEntities int      `json:"numLicensedEntities"`
+	NumInUseEntities    int      `json:"numInUseEntities"`
+	CountedEntity       string   `json:"countedEntity,omitempty"`
+}
+
+// Version of a Turbonomic instance
+type ProductVersion struct {
+	VersionInfo   string `json:"versionInfo"`
+	Updates       string `json:"updates,omitempty"`
+	MarketVersion int    `json:"marketVersion,omitempty"`
+}
+
+// Health of a category of appliance components, such as targets or action execution
+type HealthCategory struct {
+	HealthCategory      string       `json:"healthCategory"`
+	CategoryDisplayName string       `json:"categoryDisplayName,omitempty"`
+	HealthState         string       `json:"healthState"`
+	ResponseItems       []HealthItem `json:"responseItems,omitempty"`
+}
+
+// Health of a single appliance component
+type HealthItem struct {
+	HealthState       string `json:"healthState"`
+	Subcategory       string `json:"subcategory,omitempty"`
+	Description       string `json:"description,omitempty"`
+	NumberOfItems     int    `json:"numberOfItems,omitempty"`
+	RecommendedAction string `json:"recommendedAction,omitempty"`
+}
+
+// Readiness of a Turbonomic instance
+type HealthSummary struct {
+	Version               string `json:"version"`
+	LicenseExpired        bool   `json:"licenseExpired"`
+	LicenseExpirationDate string `json:"licenseExpirationDate,omitempty"`
+	// Most severe health state of the appliance components
+	ComponentHealth string `json:"componentHealth"`
+	// The appliance offers the export of its diagnostics
+	DiagnosticsAvailable bool `json:"diagnosticsAvailable"`
+	// The API answered, the license has not expired and no component is critical
+	Ready bool `json:"ready"`
+}
+
+// Retrives the installed licenses
+func (c *Client) GetLicenses(reqParams CommonReqParams) ([]License, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/licenses", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var licenses []License
+	if err := json.Unmarshal(restResp, &licenses); err != nil {
+		return nil, err
+	}
+
+	return licenses, nil
+}
+
+// Retrives the combined expiry, features and workload counts of the installed licenses
+func (c *Client) GetLicenseSummary(reqParams CommonReqParams) (*LicenseSummary, error) {
+	return c.getLicenseSummary(context.Background(), reqParams)
+}
+
+// Retrives the version of the Turbonomic instance
+func (c *Client) GetVersion(reqParams CommonReqParams) (*ProductVersion, error) {
+	return c.getVersion(context.Background(), reqParams)
+}
+
+// Retrives the health of the appliance components
+func (c *Client) GetApplianceHealth(reqParams CommonReqParams) ([]HealthCategory, error) {
+	return c.getApplianceHealth(context.Background(), reqParams)
+}
+
+func (c *Client) getApplianceHealth(ctx context.Context, reqParams CommonReqParams) ([]HealthCategory, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: "GET", Path: "/admin/health", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var health []HealthCategory
+	if err := json.Unmarshal(restResp, &health); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+// Summarizes the readiness of the Turbonomic instance from its version,
+// license summary and component health, along with the availability of the
+// diagnostics export. None of them is computed on request, so the summary is
+// cheap enough for a readiness probe. An error is returned when the API
+// cannot be reached.
+func (c *Client) Health(ctx context.Context) (*HealthSummary, error) {
+
+	version, err := c.getVersion(ctx, CommonReqParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	license, err := c.getLicenseSummary(ctx, CommonReqParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := c.getApplianceHealth(ctx, CommonReqParams{})
+	if err != nil {
+		return nil, err
+	}
+	componentHealth := HealthNormal
+	for _, category := range components {
+		if slices.Index(healthSeverity, category.HealthState) > slices.Index(healthSeverity, componentHealth) {
+			componentHealth = category.HealthState
+		}
+	}
+
+	diagnosticsAvailable, err := c.diagnosticsAvailable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HealthSummary{
+		Version:               version.Version(),
+		LicenseExpired:        license.IsExpired,
+		LicenseExpirationDate: license.ExpirationDate,
+		ComponentHealth:       componentHealth,
+		DiagnosticsAvailable:  diagnosticsAvailable,
+		Ready:                 !license.IsExpired && componentHealth != HealthCritical,
+	}, nil
+}
+
+// Reports whether the appliance offers the export of its diagnostics. The
+// methods allowed on the export are requested, as retriving it would
+// generate the diagnostics.
+func (c *Client) diagnosticsAvailable(ctx context.Context) (bool, error) {
+
+	_, headers, err := c.requestWithHeaders(ctx, RequestOptions{Method: "OPTIONS", Path: diagnosticsPath, ReqDTO: new(bytes.Buffer)})
+	if err != nil {
+		// The appliance answered, but does not offer the export
+		if headers != nil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, method := range strings.Split(headers.Get("Allow"), ",") {
+		if strings.TrimSpace(method) == "GET" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns the version number from the version information, such as 8.15.3
+func (v ProductVersion) Version() string {
+	for _, line := range strings.Split(v.VersionInfo, "\n") {
+		if version, ok := strings.CutPrefix(strings.TrimSpace(line), "Turbonomic Operations Manager"); ok {
+			fields := strings.Fields(version)
+			if len(fields) > 0 {
+				return fields[0]
+			}
+		}
+	}
+	return strings.TrimSpace(v.VersionInfo)
+}
+
+func (c *Client) getLicenseSummary(ctx context.Context, reqParams CommonReqParams) (*LicenseSummary, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: "GET", Path: "/licenses/summary", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var summary LicenseSummary
+	if err := json.Unmarshal(restResp, &summary); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+func (c *Client) getVersion(ctx context.Context, reqParams CommonReqParams) (*ProductVersion, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: "GET", Path: "/admin/versions", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var version ProductVersion
+	if err := json.Unmarshal(restResp, &version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	expired := false
+	componentHealth := HealthMinor
+	diagnostics := true
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/admin/diagnostics" {
+			// Only the allowed methods are requested, not the export itself
+			assert.Equal(t, "OPTIONS", r.Method)
+			if !diagnostics {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		assert.Equal(t, "GET", r.Method)
+
+		var response string
+		switch r.URL.Path {
+		case "/admin/health":
+			response = `[{"healthCategory":"TARGET","healthState":"NORMAL"},
+				{"healthCategory":"ACTION","healthState":"` + componentHealth + `"}]`
+		case "/admin/versions":
+			response = `{"versionInfo":"Turbonomic Operations Manager 8.15.3 (Build \"20250101\") \"2025-01-01 00:00:00\"\n\nsupported","updates":"","marketVersion":2}`
+		case "/licenses/summary":
+			if expired {
+				response = `{"expirationDate":"Jan 01, 2025","isExpired":true,"numLicensedEntities":500,"numInUseEntities":420}`
+			} else {
+				response = `{"expirationDate":"Jan 01, 2027","isExpired":false,"numLicensedEntities":500,"numInUseEntities":420,
+					"features":["planner","action_script"],"countedEntity":"VM"}`
+			}
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	health, err := client.Health(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &HealthSummary{Version: "8.15.3", LicenseExpirationDate: "Jan 01, 2027", ComponentHealth: HealthMinor,
+		DiagnosticsAvailable: true, Ready: true}, health)
+
+	summary, err := client.GetLicenseSummary(CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, 420, summary.NumInUseEntities)
+	assert.Equal(t, []string{"planner", "action_script"}, summary.Features)
+
+	componentHealth = HealthCritical
+	diagnostics = false
+	health, err = client.Health(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, HealthCritical, health.ComponentHealth)
+	assert.False(t, health.DiagnosticsAvailable)
+	assert.False(t, health.Ready)
+
+	componentHealth = HealthNormal
+	expired = true
+	health, err = client.Health(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, health.LicenseExpired)
+	assert.False(t, health.Ready)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Health(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetApplianceHealth(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/admin/health", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"healthCategory":"TARGET","categoryDisplayName":"Targets","healthState":"MAJOR",
+			"responseItems":[{"healthState":"MAJOR","subcategory":"DISCOVERY","numberOfItems":2,
+			"description":"Discovery failed"}]}]`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	health, err := client.GetApplianceHealth(CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, HealthMajor, health[0].HealthState)
+	assert.Equal(t, 2, health[0].ResponseItems[0].NumberOfItems)
+}
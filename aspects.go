@@ -0,0 +1,119 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"encoding/json"
+)
+
+// Names of the entity aspects decoded into typed structs
+const (
+	VirtualMachineAspectName = "virtualMachineAspect"
+	VirtualDisksAspectName   = "virtualDisksAspect"
+	CloudAspectName          = "cloudAspect"
+)
+
+// Aspects of an entity keyed by aspect name. Aspects without a typed struct
+// are kept undecoded in Other.
+type EntityAspects struct {
+	VirtualMachineAspect *VirtualMachineAspect      `json:"virtualMachineAspect,omitempty"`
+	VirtualDisksAspect   *VirtualDisksAspect        `json:"virtualDisksAspect,omitempty"`
+	CloudAspect          *CloudAspect               `json:"cloudAspect,omitempty"`
+	Other                map[string]json.RawMessage `json:"-"`
+}
+
+// Guest and configuration details of a virtual machine
+type VirtualMachineAspect struct {
+	Os                string       `json:"os,omitempty"`
+	IP                []string     `json:"ip,omitempty"`
+	NumVCPUs          int          `json:"numVCPUs,omitempty"`
+	NumSockets        int          `json:"numSockets,omitempty"`
+	CoresPerSocket    int          `json:"coresPerSocketRatio,omitempty"`
+	EbsOptimized      bool         `json:"ebsOptimized,omitempty"`
+	ResourceID        string       `json:"resourceId,omitempty"`
+	CreationTimeStamp int64        `json:"creationTimeStamp,omitempty"`
+	ConnectedNetworks []BaseApiDTO `json:"connectedNetworks,omitempty"`
+	Type              string       `json:"type,omitempty"`
+}
+
+// Virtual disks attached to a virtual machine
+type VirtualDisksAspect struct {
+	VirtualDisks []VirtualDisk `json:"virtualDisks"`
+	Type         string        `json:"type,omitempty"`
+}
+
+// Single virtual disk and the storage providing it
+type VirtualDisk struct {
+	UUID                   string           `json:"uuid"`
+	DisplayName            string           `json:"displayName"`
+	Tier                   string           `json:"tier,omitempty"`
+	Provider               *BaseApiDTO      `json:"provider,omitempty"`
+	AttachedVirtualMachine *BaseApiDTO      `json:"attachedVirtualMachine,omitempty"`
+	DataCenter             *BaseApiDTO      `json:"dataCenter,omitempty"`
+	BusinessAccount        *BusinessAccount `json:"businessAccount,omitempty"`
+	EnvironmentType        string           `json:"environmentType,omitempty"`
+	Stats                  []Statistic      `json:"stats,omitempty"`
+	SnapshotID             string           `json:"snapshotId,omitempty"`
+	Encryption             string           `json:"encryption,omitempty"`
+	AttachmentState        string           `json:"attachmentState,omitempty"`
+	ResourceID             string           `json:"resourceId,omitempty"`
+	CreationTimeStamp      int64            `json:"creationTimeStamp,omitempty"`
+	LastModified           int64            `json:"lastModified,omitempty"`
+}
+
+// Cloud account and resource group of a cloud entity
+type CloudAspect struct {
+	BusinessAccount      *BusinessAccount `json:"businessAccount,omitempty"`
+	ResourceGroup        *BaseApiDTO      `json:"resourceGroup,omitempty"`
+	ResourceID           string           `json:"resourceId,omitempty"`
+	RiCoveragePercentage float64          `json:"riCoveragePercentage,omitempty"`
+	Type                 string           `json:"type,omitempty"`
+}
+
+// Decodes the aspects of an entity, such as the aspects of an action target
+func DecodeAspects(raw json.RawMessage) (*EntityAspects, error) {
+	aspects := &EntityAspects{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return aspects, nil
+	}
+	if err := json.Unmarshal(raw, aspects); err != nil {
+		return nil, err
+	}
+	return aspects, nil
+}
+
+// Decodes the typed aspects and keeps the others in Other
+func (a *EntityAspects) UnmarshalJSON(data []byte) error {
+	type typedAspects EntityAspects
+	var typed typedAspects
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for _, name := range []string{VirtualMachineAspectName, VirtualDisksAspectName, CloudAspectName} {
+		delete(all, name)
+	}
+	if len(all) > 0 {
+		typed.Other = all
+	}
+
+	*a = EntityAspects(typed)
+	return nil
+}
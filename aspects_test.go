@@ -0,0 +1,49 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeActionTargetAspects(t *testing.T) {
+	mockResponse, err := os.ReadFile("./testfiles/GetActionsByUuidCompound.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	var actions ActionResults
+	assert.NoError(t, json.Unmarshal(mockResponse, &actions))
+
+	aspects, err := DecodeAspects(actions[0].Target.Aspects)
+	assert.NoError(t, err)
+	assert.Nil(t, aspects.VirtualMachineAspect)
+	assert.Contains(t, aspects.Other, "workloadControllerAspect")
+	assert.Contains(t, aspects.Other, "containerPlatformContextAspect")
+}
+
+func TestDecodeEmptyAspects(t *testing.T) {
+	aspects, err := DecodeAspects(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, aspects.Other)
+
+	_, err = DecodeAspects(json.RawMessage(`[]`))
+	assert.Error(t, err)
+}
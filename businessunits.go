@@ -0,0 +1,195 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Cloud providers of business accounts
+const (
+	CloudTypeAWS   = "AWS"
+	CloudTypeAzure = "AZURE"
+	CloudTypeGCP   = "GCP"
+)
+
+// Type of business units discovered from cloud targets
+const DiscoveredBusinessUnit = "DISCOVERED"
+
+// Parameters for listing business accounts from Turbonomic's API
+type BusinessAccountsRequest struct {
+	// Business unit type, discovered accounts by default
+	Type            string
+	CloudType       string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving a business account from Turbonomic's API
+type BusinessAccountRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving statistics of a business account, such as costPrice
+type BusinessAccountStatsRequest struct {
+	Uuid            string
+	StartDate       string
+	EndDate         string
+	Statistics      []StatisticRequest
+	CommonReqParams CommonReqParams
+}
+
+// Cloud account, such as an AWS account, Azure subscription or GCP project
+type BusinessAccount struct {
+	UUID            string `json:"uuid"`
+	DisplayName     string `json:"displayName"`
+	ClassName       string `json:"className"`
+	EnvironmentType string `json:"environmentType,omitempty"`
+	DiscoveredBy    struct {
+		UUID        string `json:"uuid"`
+		DisplayName string `json:"displayName"`
+		Category    string `json:"category"`
+		Type        string `json:"type"`
+		Readonly    bool   `json:"readonly"`
+	} `json:"discoveredBy"`
+	VendorIds         map[string]string   `json:"vendorIds,omitempty"`
+	State             string              `json:"state,omitempty"`
+	Severity          string              `json:"severity,omitempty"`
+	SeverityBreakdown map[string]int      `json:"severityBreakdown,omitempty"`
+	Tags              map[string][]string `json:"tags,omitempty"`
+	Staleness         string              `json:"staleness,omitempty"`
+	// Fields returned by the business units API
+	BusinessUnitType      string   `json:"businessUnitType,omitempty"`
+	CloudType             string   `json:"cloudType,omitempty"`
+	AccountId             string   `json:"accountId,omitempty"`
+	Master                bool     `json:"master,omitempty"`
+	ChildrenBusinessUnits []string `json:"childrenBusinessUnits,omitempty"`
+	CostPrice             float64  `json:"costPrice,omitempty"`
+	Savings               float64  `json:"savings,omitempty"`
+	ResourceGroupsCount   int      `json:"resourceGroupsCount,omitempty"`
+	HasRelatedTarget      bool     `json:"hasRelatedTarget,omitempty"`
+	Targets               []Target `json:"targets,omitempty"`
+}
+
+// Master account, such as an AWS organization, and its member accounts
+type BillingFamily struct {
+	Master  BusinessAccount   `json:"master"`
+	Members []BusinessAccount `json:"members"`
+}
+
+// Retrives the business accounts, optionally of a single cloud provider
+func (c *Client) GetBusinessAccounts(accountsReq BusinessAccountsRequest) ([]BusinessAccount, error) {
+
+	unitType := accountsReq.Type
+	if unitType == "" {
+		unitType = DiscoveredBusinessUnit
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/businessunits", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: withQueryParameters(accountsReq.CommonReqParams, map[string]string{
+			"type":       unitType,
+			"cloud_type": accountsReq.CloudType,
+		})})
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []BusinessAccount
+	if err := json.Unmarshal(restResp, &accounts); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// Retrives a business account based on its provided uuid
+func (c *Client) GetBusinessAccount(accountReq BusinessAccountRequest) (*BusinessAccount, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/businessunits/" + accountReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: accountReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var account BusinessAccount
+	if err := json.Unmarshal(restResp, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// Retrives the billing families, grouping the member accounts of each master
+// account, optionally of a single cloud provider
+func (c *Client) GetBillingFamilies(accountsReq BusinessAccountsRequest) ([]BillingFamily, error) {
+
+	accounts, err := c.GetBusinessAccounts(accountsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	byUuid := make(map[string]BusinessAccount, len(accounts))
+	for _, account := range accounts {
+		byUuid[account.UUID] = account
+	}
+
+	var families []BillingFamily
+	for _, account := range accounts {
+		if !account.Master {
+			continue
+		}
+		family := BillingFamily{Master: account}
+		for _, child := range account.ChildrenBusinessUnits {
+			if member, ok := byUuid[child]; ok {
+				family.Members = append(family.Members, member)
+			}
+		}
+		families = append(families, family)
+	}
+
+	return families, nil
+}
+
+// Retrives statistics of a business account, such as its cost and savings
+func (c *Client) GetBusinessAccountStats(statsReq BusinessAccountStatsRequest) (StatsResponse, error) {
+
+	requestBody := StatsRequestBody{
+		StartDate:  statsReq.StartDate,
+		EndDate:    statsReq.EndDate,
+		Statistics: statsReq.Statistics,
+	}
+
+	return c.postStats("/businessunits/"+statsReq.Uuid+"/stats", requestBody, statsReq.CommonReqParams)
+}
+
+// Retrives the business account owning the cloud entity with the provided uuid
+func (c *Client) GetEntityBusinessAccount(reqOpts EntityRequest) (*BusinessAccount, error) {
+
+	aspect, err := c.GetEntityAspect(EntityAspectRequest{Uuid: reqOpts.Uuid, AspectName: CloudAspectName,
+		CommonReqOptions: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+	if aspect.CloudAspect == nil || aspect.CloudAspect.BusinessAccount == nil {
+		return nil, fmt.Errorf("entity %s is not owned by a business account", reqOpts.Uuid)
+	}
+
+	return c.GetBusinessAccount(BusinessAccountRequest{Uuid: aspect.CloudAspect.BusinessAccount.UUID,
+		CommonReqParams: reqOpts.CommonReqOptions})
+}
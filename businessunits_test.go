@@ -0,0 +1,126 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBillingFamilies(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetBusinessAccounts.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/businessunits", r.URL.Path)
+		assert.Equal(t, "DISCOVERED", r.URL.Query().Get("type"))
+		assert.Equal(t, "AWS", r.URL.Query().Get("cloud_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	accounts, err := client.GetBusinessAccounts(BusinessAccountsRequest{CloudType: CloudTypeAWS})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(accounts))
+	assert.Equal(t, "aws-organization", accounts[0].Targets[0].DisplayName)
+	assert.Equal(t, 3.25, accounts[0].Savings)
+	assert.Equal(t, []string{"dev"}, accounts[1].Tags["Usage"])
+
+	families, err := client.GetBillingFamilies(BusinessAccountsRequest{CloudType: CloudTypeAWS})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(families))
+	assert.Equal(t, "111111111111", families[0].Master.AccountId)
+	assert.Equal(t, 2, len(families[0].Members))
+	assert.Equal(t, "Production", families[0].Members[1].DisplayName)
+}
+
+func TestGetEntityBusinessAccount(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		var response string
+		switch r.URL.Path {
+		case "/entities/75941320319680/aspects/cloudAspect":
+			response = `{"businessAccount":{"uuid":"75941320066666","displayName":"Development"},"type":"CloudAspectApiDTO"}`
+		case "/entities/75941320319681/aspects/cloudAspect":
+			response = `{"type":"CloudAspectApiDTO"}`
+		case "/businessunits/75941320066666":
+			response = `{"uuid":"75941320066666","displayName":"Development","className":"BusinessAccount",
+				"cloudType":"AWS","accountId":"222222222222"}`
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	account, err := client.GetEntityBusinessAccount(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Equal(t, "222222222222", account.AccountId)
+	assert.Equal(t, CloudTypeAWS, account.CloudType)
+
+	_, err = client.GetEntityBusinessAccount(EntityRequest{Uuid: "75941320319681"})
+	assert.Error(t, err)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
@@ -61,8 +62,114 @@ type T8cClient interface {
 	GetEntityTags(reqOpts EntityRequest) ([]Tag, error)
 	TagEntity(reqOpts TagEntityRequest) ([]Tag, error)
 	SearchEntities(searchCriteria SearchDTO, reqParams CommonReqParams) (SearchResults, error)
+	Search(search *SearchBuilder, reqParams CommonReqParams) (SearchResults, error)
 	SearchEntityByName(searchReq SearchRequest) (SearchResults, error)
+	QuickSearch(searchReq QuickSearchRequest) (*SearchPage, error)
+	FindEntityByVendorID(lookupReq EntityLookupRequest) (*SearchResult, error)
+	FindEntityByResourceID(lookupReq EntityLookupRequest) (*SearchResult, error)
+	FindEntityByIP(lookupReq EntityLookupRequest) (*SearchResult, error)
+	GetSearchCriteria(reqParams CommonReqParams) ([]SearchCriterion, error)
+	GetSearchCriteriaOptions(filterType string, reqParams CommonReqParams) ([]CriteriaOption, error)
 	GetStats(statsReq StatsRequest) (StatsResponse, error)
+	GetScopedStats(statsReq ScopedStatsRequest) (ScopedStatsResponse, error)
+	GetGroups(reqParams CommonReqParams) ([]Group, error)
+	GetGroup(groupReq GroupRequest) (*Group, error)
+	SearchGroups(searchReq GroupSearchRequest) ([]Group, error)
+	CreateGroup(groupReq GroupInputRequest) (*Group, error)
+	UpdateGroup(groupReq GroupInputRequest) (*Group, error)
+	DeleteGroup(groupReq GroupRequest) error
+	GetGroupMembers(groupReq GroupRequest) ([]EntityResults, error)
+	GetGroupEntities(groupReq GroupRequest) ([]EntityResults, error)
+	GetGroupLeafEntities(groupReq GroupRequest) ([]EntityResults, error)
+	GetPlacementPolicies(reqParams CommonReqParams) ([]PlacementPolicy, error)
+	GetPlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error)
+	CreatePlacementPolicy(policyReq PlacementPolicyInputRequest) (*PlacementPolicy, error)
+	UpdatePlacementPolicy(policyReq PlacementPolicyInputRequest) (*PlacementPolicy, error)
+	EnablePlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error)
+	DisablePlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error)
+	DeletePlacementPolicy(policyReq PlacementPolicyRequest) error
+	GetSettingsPolicies(policiesReq SettingsPoliciesRequest) ([]SettingsPolicy, error)
+	GetDefaultSettingsPolicies(reqParams CommonReqParams) ([]SettingsPolicy, error)
+	GetSettingsPolicy(policyReq SettingsPolicyRequest) (*SettingsPolicy, error)
+	CreateSettingsPolicy(policyReq SettingsPolicyInputRequest) (*SettingsPolicy, error)
+	UpdateSettingsPolicy(policyReq SettingsPolicyInputRequest) (*SettingsPolicy, error)
+	DeleteSettingsPolicy(policyReq SettingsPolicyRequest) error
+	GetSchedules(reqParams CommonReqParams) ([]Schedule, error)
+	GetSchedule(scheduleReq ScheduleRequest) (*Schedule, error)
+	CreateSchedule(scheduleReq ScheduleInputRequest) (*Schedule, error)
+	UpdateSchedule(scheduleReq ScheduleInputRequest) (*Schedule, error)
+	DeleteSchedule(scheduleReq ScheduleRequest) error
+	GetSchedulePolicies(scheduleReq ScheduleRequest) ([]SettingsPolicy, error)
+	GetTargets(reqParams CommonReqParams) ([]Target, error)
+	GetTarget(targetReq TargetRequest) (*Target, error)
+	GetProbes(reqParams CommonReqParams) ([]Probe, error)
+	GetProbe(probeReq ProbeRequest) (*Probe, error)
+	AddTarget(targetReq TargetInputRequest) (*Target, error)
+	UpdateTarget(targetReq TargetInputRequest) (*Target, error)
+	ValidateTarget(targetReq TargetRequest) (*Target, error)
+	RediscoverTarget(targetReq TargetRequest) (*Target, error)
+	DeleteTarget(targetReq TargetRequest) error
+	WaitForDiscovery(ctx context.Context, waitReq WaitForDiscoveryRequest) (*Target, error)
+	CreateScenario(scenarioReq ScenarioInputRequest) (*Scenario, error)
+	DeleteScenario(scenarioReq ScenarioRequest) error
+	RunScenario(runReq RunScenarioRequest) (*Market, error)
+	GetMarket(marketReq MarketRequest) (*Market, error)
+	WaitForPlan(ctx context.Context, waitReq WaitForPlanRequest) (*Market, error)
+	GetMarketEntities(marketReq MarketRequest) ([]EntityResults, error)
+	GetPlanActions(actionsReq PlanActionsRequest) (ActionResults, error)
+	GetPlanStats(statsReq PlanStatsRequest) (StatsResponse, error)
+	DeletePlan(marketReq MarketRequest) error
+	RunCloudMigration(ctx context.Context, migrationReq MigrationRequest) (*MigrationReport, error)
+	GetReservations(reqParams CommonReqParams) ([]Reservation, error)
+	GetReservation(reservationReq ReservationRequest) (*Reservation, error)
+	CreateReservation(reservationReq ReservationInputRequest) (*Reservation, error)
+	DeleteReservation(reservationReq ReservationRequest) error
+	WaitForReservation(ctx context.Context, waitReq WaitForReservationRequest) (*Reservation, error)
+	GetTemplates(reqParams CommonReqParams) ([]Template, error)
+	GetTemplate(templateReq TemplateRequest) (*Template, error)
+	GetTemplateByName(templateReq TemplateByNameRequest) (*Template, error)
+	CreateTemplate(templateReq TemplateInputRequest) (*Template, error)
+	UpdateTemplate(templateReq TemplateInputRequest) (*Template, error)
+	DeleteTemplate(templateReq TemplateRequest) error
+	GetSupplyChain(ctx context.Context, scopeUUIDs []string, options SupplyChainOptions) (*SupplyChain, error)
+	GetEntityProviders(reqOpts EntityRequest) ([]EntityResults, error)
+	GetEntityConsumers(reqOpts EntityRequest) ([]EntityResults, error)
+	GetEntityAspects(reqOpts EntityRequest) (*EntityAspects, error)
+	GetEntityAspect(reqOpts EntityAspectRequest) (*EntityAspects, error)
+	GetEntitySettings(reqOpts EntitySettingsRequest) (EntitySettings, error)
+	GetEntityPlacementPolicies(reqOpts EntityRequest) ([]PlacementPolicy, error)
+	GetEntityPolicies(reqOpts EntityRequest) (*EntityPolicies, error)
+	DeleteEntityTag(reqOpts EntityTagRequest) ([]Tag, error)
+	DeleteAllEntityTags(reqOpts EntityRequest) ([]Tag, error)
+	SetEntityTags(reqOpts TagEntityRequest) ([]Tag, error)
+	GetGroupTags(groupReq GroupRequest) ([]Tag, error)
+	TagGroup(tagReq TagGroupRequest) ([]Tag, error)
+	DeleteGroupTag(tagReq GroupTagRequest) ([]Tag, error)
+	DeleteAllGroupTags(groupReq GroupRequest) ([]Tag, error)
+	SetGroupTags(tagReq TagGroupRequest) ([]Tag, error)
+	GetTags(tagsReq TagsRequest) ([]Tag, error)
+	SearchEntitiesByTag(tagReq TagSearchRequest) (SearchResults, error)
+	GetCostStats(costReq CostRequest) (CostStats, error)
+	GetBusinessAccounts(accountsReq BusinessAccountsRequest) ([]BusinessAccount, error)
+	GetBusinessAccount(accountReq BusinessAccountRequest) (*BusinessAccount, error)
+	GetBillingFamilies(accountsReq BusinessAccountsRequest) ([]BillingFamily, error)
+	GetBusinessAccountStats(statsReq BusinessAccountStatsRequest) (StatsResponse, error)
+	GetEntityBusinessAccount(reqOpts EntityRequest) (*BusinessAccount, error)
+	GetUsers(reqParams CommonReqParams) ([]User, error)
+	GetUser(userReq UserRequest) (*User, error)
+	GetCurrentUser(reqParams CommonReqParams) (*User, error)
+	CreateUser(userReq UserInputRequest) (*User, error)
+	UpdateUser(userReq UserInputRequest) (*User, error)
+	DeleteUser(userReq UserRequest) error
+	GetOAuthClients(reqParams CommonReqParams) ([]OAuthClient, error)
+	CreateOAuthClient(clientReq OAuthClientInputRequest) (*OAuthClient, error)
+	DeleteOAuthClient(clientReq OAuthClientRequest) error
+	GetLicenses(reqParams CommonReqParams) ([]License, error)
+	GetLicenseSummary(reqParams CommonReqParams) (*LicenseSummary, error)
+	GetVersion(reqParams CommonReqParams) (*ProductVersion, error)
+	GetApplianceHealth(reqParams CommonReqParams) ([]HealthCategory, error)
+	Health(ctx context.Context) (*HealthSummary, error)
+	Subscribe(ctx context.Context) (<-chan Notification, error)
 }
 
 // Turbonomic Client
@@ -72,6 +179,8 @@ type Client struct {
 	Headers    map[string]string
 	Logger     logging.LoggerCustom
 	Ctx        context.Context
+
+	searchCriteria searchCriteriaCache
 }
 
 type CommonReqParams struct {
@@ -195,18 +304,85 @@ func setParams(baseUrl string, QueryParameters map[string]string) (*url.URL, err
 	return fullUrl, err
 }
 
+// Returns a copy of the request parameters with the provided query parameters
+// added, leaving values already set by the caller untouched
+func withQueryParameters(reqParams CommonReqParams, queryParameters map[string]string) CommonReqParams {
+	merged := make(map[string]string, len(reqParams.QueryParameters)+len(queryParameters))
+	for k, v := range queryParameters {
+		if v != "" {
+			merged[k] = v
+		}
+	}
+	for k, v := range reqParams.QueryParameters {
+		merged[k] = v
+	}
+
+	return CommonReqParams{Headers: reqParams.Headers, QueryParameters: merged}
+}
+
+// Returns the request parameters with the cursor of the page to retrive,
+// replacing any cursor provided by the caller
+func withCursor(reqParams CommonReqParams, cursor string) CommonReqParams {
+	queryParameters := map[string]string{}
+	for k, v := range reqParams.QueryParameters {
+		if k != "cursor" {
+			queryParameters[k] = v
+		}
+	}
+	if cursor != "" {
+		queryParameters["cursor"] = cursor
+	}
+
+	return CommonReqParams{Headers: reqParams.Headers, QueryParameters: queryParameters}
+}
+
+// Calls check every interval until it reports completion or fails, returning
+// an error once the timeout has elapsed or the context is done
+func poll(ctx context.Context, interval, timeout time.Duration, check func(context.Context) (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		timer.Reset(interval)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // Make request to Turbonomic API using http package
 func (c *Client) request(reqOpt RequestOptions) ([]byte, error) {
+	return c.requestWithContext(context.Background(), reqOpt)
+}
+
+// Sends the request, cancelling it once the provided context is done
+func (c *Client) requestWithContext(ctx context.Context, reqOpt RequestOptions) ([]byte, error) {
+	respBody, _, err := c.requestWithHeaders(ctx, reqOpt)
+	return respBody, err
+}
+
+// Sends the request, also returning the headers of the response, which carry
+// the pagination cursors of Turbonomic's API
+func (c *Client) requestWithHeaders(ctx context.Context, reqOpt RequestOptions) ([]byte, http.Header, error) {
 
 	baseUrl := c.BaseURL + reqOpt.Path
 	fullUrl, err := setParams(baseUrl, reqOpt.CommonReqParams.QueryParameters)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	restReq, err := http.NewRequest(reqOpt.Method, fullUrl.String(), reqOpt.ReqDTO)
+	restReq, err := http.NewRequestWithContext(ctx, reqOpt.Method, fullUrl.String(), reqOpt.ReqDTO)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	restReq.Header.Add("Content-Type", "application/json")
@@ -221,19 +397,19 @@ func (c *Client) request(reqOpt RequestOptions) ([]byte, error) {
 
 	restResp, err := c.HTTPClient.Do(restReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	respBody, err := io.ReadAll(restResp.Body)
 	defer restResp.Body.Close()
 
 	if restResp.StatusCode >= 400 {
 		err = errors.New(string(respBody))
-		return respBody, err
+		return respBody, restResp.Header, err
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return respBody, err
+	return respBody, restResp.Header, err
 }
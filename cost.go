@@ -0,0 +1,168 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"time"
+)
+
+// Name of the statistic holding the hourly cost of cloud entities
+const CostPriceStat = "costPrice"
+
+// Epochs of a statistic, the projected epoch holding values after actions
+const (
+	HistoricalEpoch = "HISTORICAL"
+	CurrentEpoch    = "CURRENT"
+	ProjectedEpoch  = "PROJECTED"
+)
+
+// Dimension along which cloud costs are broken down
+type CostGroupBy string
+
+const (
+	COST_BY_CLOUD_SERVICE CostGroupBy = "cloudService"
+	COST_BY_ACCOUNT       CostGroupBy = "businessUnit"
+	COST_BY_REGION        CostGroupBy = "location"
+	COST_BY_PROVIDER      CostGroupBy = "CSP"
+	COST_BY_TAG           CostGroupBy = "tag"
+	COST_BY_COMPONENT     CostGroupBy = "costComponent"
+)
+
+// Component of the cost of a cloud entity
+type CostComponent string
+
+const (
+	COST_COMPONENT_COMPUTE CostComponent = "COMPUTE"
+	COST_COMPONENT_STORAGE CostComponent = "STORAGE"
+	COST_COMPONENT_LICENSE CostComponent = "LICENSE"
+	COST_COMPONENT_IP      CostComponent = "IP"
+)
+
+// Parameters for retriving cloud cost statistics from Turbonomic's API
+type CostRequest struct {
+	// Entity, group, account or plan market in scope, the whole environment when empty
+	Scope string
+	// Dates as accepted by the stats API, an EndDate in the future includes
+	// the projected cost after actions
+	StartDate string
+	EndDate   string
+	// Restricts the cost to an entity type, such as VirtualMachine
+	RelatedEntityType string
+	GroupBy           []CostGroupBy
+	CostComponents    []CostComponent
+	CommonReqParams   CommonReqParams
+}
+
+// Cost statistics returned by Turbonomic's API
+type CostStats []CostStat
+
+// Hourly cost at a point in time, for a single group when broken down
+type CostStat struct {
+	Date  time.Time `json:"date"`
+	Epoch string    `json:"epoch"`
+	Cost  float64   `json:"cost"`
+	Units string    `json:"units,omitempty"`
+	// Value of each dimension the cost is broken down by
+	Groups map[CostGroupBy]string `json:"groups,omitempty"`
+}
+
+// Retrives the cloud cost of a scope over a date range, broken down by the
+// provided dimensions and restricted to the provided cost components
+func (c *Client) GetCostStats(costReq CostRequest) (CostStats, error) {
+
+	scope := costReq.Scope
+	if scope == "" {
+		scope = RealtimeMarket
+	}
+
+	statistic := StatisticRequest{Name: CostPriceStat, RelatedEntityType: costReq.RelatedEntityType}
+	for _, groupBy := range costReq.GroupBy {
+		statistic.GroupBy = append(statistic.GroupBy, string(groupBy))
+	}
+	for _, component := range costReq.CostComponents {
+		statistic.Filters = append(statistic.Filters, Filter{Type: string(COST_BY_COMPONENT), Value: string(component)})
+	}
+
+	requestBody := StatsRequestBody{
+		StartDate:  costReq.StartDate,
+		EndDate:    costReq.EndDate,
+		Statistics: []StatisticRequest{statistic},
+	}
+
+	stats, err := c.postStats("/stats/"+scope, requestBody, costReq.CommonReqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var costStats CostStats
+	for _, snapshot := range stats {
+		for _, stat := range snapshot.Statistics {
+			if stat.Name != CostPriceStat {
+				continue
+			}
+			costStat := CostStat{Date: snapshot.Date, Epoch: snapshot.Epoch, Cost: stat.Value, Units: stat.Units}
+			for _, filter := range stat.Filters {
+				if costStat.Groups == nil {
+					costStat.Groups = map[CostGroupBy]string{}
+				}
+				costStat.Groups[CostGroupBy(filter.Type)] = filter.Value
+			}
+			costStats = append(costStats, costStat)
+		}
+	}
+
+	return costStats, nil
+}
+
+// Returns the cost statistics recorded before the request, excluding projections
+func (s CostStats) Historical() CostStats {
+	var historical CostStats
+	for _, stat := range s {
+		if stat.Epoch != ProjectedEpoch {
+			historical = append(historical, stat)
+		}
+	}
+	return historical
+}
+
+// Returns the projected cost statistics after actions are executed
+func (s CostStats) Projected() CostStats {
+	var projected CostStats
+	for _, stat := range s {
+		if stat.Epoch == ProjectedEpoch {
+			projected = append(projected, stat)
+		}
+	}
+	return projected
+}
+
+// Returns the sum of the costs of the statistics
+func (s CostStats) Total() float64 {
+	var total float64
+	for _, stat := range s {
+		total += stat.Cost
+	}
+	return total
+}
+
+// Sums the costs of the statistics by their value of the provided dimension
+func (s CostStats) By(groupBy CostGroupBy) map[string]float64 {
+	totals := map[string]float64{}
+	for _, stat := range s {
+		totals[stat.Groups[groupBy]] += stat.Cost
+	}
+	return totals
+}
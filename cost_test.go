@@ -0,0 +1,83 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCostStats(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetCostStats.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/stats/Market", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"startDate\":\"-1d\",\"endDate\":\"+1d\",\"statistics\":[{\"name\":\"costPrice\","+
+			"\"relatedEntityType\":\"VirtualMachine\","+
+			"\"filters\":[{\"type\":\"costComponent\",\"value\":\"COMPUTE\",\"displayName\":null},"+
+			"{\"type\":\"costComponent\",\"value\":\"STORAGE\",\"displayName\":null}],"+
+			"\"groupBy\":[\"cloudService\",\"costComponent\"]}]}\n", string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	costs, err := client.GetCostStats(CostRequest{
+		StartDate:         "-1d",
+		EndDate:           "+1d",
+		RelatedEntityType: "VirtualMachine",
+		GroupBy:           []CostGroupBy{COST_BY_CLOUD_SERVICE, COST_BY_COMPONENT},
+		CostComponents:    []CostComponent{COST_COMPONENT_COMPUTE, COST_COMPONENT_STORAGE},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(costs))
+	assert.Equal(t, "AWS EBS", costs[1].Groups[COST_BY_CLOUD_SERVICE])
+	assert.Equal(t, "$/h", costs[0].Units)
+
+	assert.Equal(t, 3, len(costs.Historical()))
+	assert.Equal(t, 8.0, costs.Projected().Total())
+	assert.Equal(t, map[string]float64{"COMPUTE": 24.0, "STORAGE": 2.5}, costs.Historical().By(COST_BY_COMPONENT))
+}
@@ -79,6 +79,8 @@ type EntityResults struct {
 	} `json:"template,omitempty"`
 	Tags      struct{} `json:"tags,omitempty"`
 	Staleness string   `json:"staleness,omitempty"`
+	// Set when aspects are requested with the aspect_names query parameter
+	Aspects *EntityAspects `json:"aspects,omitempty"`
 }
 
 // Retrives entity based on its provided uuid
@@ -148,3 +150,69 @@ func (c *Client) GetEntityTags(reqOpts EntityRequest) ([]Tag, error) {
 
 	return tagsResult, err
 }
+
+// Parameters for retriving a single aspect of an entity, such as virtualMachineAspect
+type EntityAspectRequest struct {
+	Uuid             string
+	AspectName       string
+	CommonReqOptions CommonReqParams
+}
+
+// Retrives the entities which the entity with the provided uuid consumes from,
+// such as the host and storage of a virtual machine
+func (c *Client) GetEntityProviders(reqOpts EntityRequest) ([]EntityResults, error) {
+	return c.getRelatedEntities(reqOpts, "/providers")
+}
+
+// Retrives the entities which consume from the entity with the provided uuid
+func (c *Client) GetEntityConsumers(reqOpts EntityRequest) ([]EntityResults, error) {
+	return c.getRelatedEntities(reqOpts, "/consumers")
+}
+
+// Retrives all aspects of the entity with the provided uuid
+func (c *Client) GetEntityAspects(reqOpts EntityRequest) (*EntityAspects, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + "/aspects", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	return DecodeAspects(restResp)
+}
+
+// Retrives a single aspect of the entity with the provided uuid, returned
+// within EntityAspects under its name
+func (c *Client) GetEntityAspect(reqOpts EntityAspectRequest) (*EntityAspects, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + "/aspects/" + reqOpts.AspectName,
+		ReqDTO: new(bytes.Buffer), CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	aspect, err := json.Marshal(map[string]json.RawMessage{reqOpts.AspectName: restResp})
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeAspects(aspect)
+}
+
+func (c *Client) getRelatedEntities(reqOpts EntityRequest, suffix string) ([]EntityResults, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + suffix, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []EntityResults
+	if err := json.Unmarshal(restResp, &entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
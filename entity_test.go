@@ -216,3 +216,109 @@ func convertEntityTest(entity EntityResults) TestEntity {
 		displayName: entity.DisplayName,
 		className:   entity.ClassName}
 }
+
+func TestGetEntityProviders(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		var response string
+		switch r.URL.Path {
+		case "/entities/75941320319680/providers":
+			response = `[{"uuid":"75941320011111","displayName":"esx-01.turbo.com","className":"PhysicalMachine"},
+				{"uuid":"75941320022222","displayName":"datastore-01","className":"Storage"}]`
+		case "/entities/75941320319680/consumers":
+			response = `[{"uuid":"75941320077777","displayName":"checkout","className":"Application"}]`
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write header: %s", err.Error())
+			t.FailNow()
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	providers, err := client.GetEntityProviders(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(providers))
+	assert.Equal(t, "PhysicalMachine", providers[0].ClassName)
+	assert.Equal(t, "datastore-01", providers[1].DisplayName)
+
+	consumers, err := client.GetEntityConsumers(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Equal(t, "checkout", consumers[0].DisplayName)
+}
+
+func TestGetEntityAspects(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetEntityAspects.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		var response []byte
+		switch r.URL.Path {
+		case "/entities/75941320319680/aspects":
+			response = mockResponse
+		case "/entities/75941320319680/aspects/cloudAspect":
+			response = []byte(`{"businessAccount":{"uuid":"75941320066666","displayName":"Development"},"type":"CloudAspectApiDTO"}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(response); err != nil {
+			t.Errorf("failed to write header: %s", err.Error())
+			t.FailNow()
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	aspects, err := client.GetEntityAspects(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Linux", aspects.VirtualMachineAspect.Os)
+	assert.Equal(t, 4, aspects.VirtualMachineAspect.NumVCPUs)
+	disk := aspects.VirtualDisksAspect.VirtualDisks[0]
+	assert.Equal(t, "GP3", disk.Provider.DisplayName)
+	assert.Equal(t, 8192.0, disk.Stats[0].Value)
+	assert.Equal(t, "Development", aspects.CloudAspect.BusinessAccount.DisplayName)
+	assert.Contains(t, aspects.Other, "computeTierAspect")
+
+	aspect, err := client.GetEntityAspect(EntityAspectRequest{Uuid: "75941320319680", AspectName: CloudAspectName})
+	assert.NoError(t, err)
+	assert.Nil(t, aspect.VirtualMachineAspect)
+	assert.Equal(t, "75941320066666", aspect.CloudAspect.BusinessAccount.UUID)
+}
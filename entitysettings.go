@@ -0,0 +1,205 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+)
+
+// Parameters for retriving the settings applied to an entity
+type EntitySettingsRequest struct {
+	Uuid string
+	// Reports the policy whose value applies to each setting
+	IncludeSettingsPolicies bool
+	CommonReqOptions        CommonReqParams
+}
+
+// Settings applied to an entity, grouped by settings manager
+type EntitySettings []SettingsManager
+
+// Effective value of a setting for an entity and the policy it comes from
+type EffectiveSetting struct {
+	ManagerUuid  string      `json:"managerUuid"`
+	SettingUuid  string      `json:"settingUuid"`
+	DisplayName  string      `json:"displayName,omitempty"`
+	Value        string      `json:"value"`
+	DefaultValue string      `json:"defaultValue,omitempty"`
+	Policy       *BaseApiDTO `json:"policy,omitempty"`
+}
+
+// Policies whose scope includes an entity
+type EntityPolicies struct {
+	PlacementPolicies []PlacementPolicy `json:"placementPolicies"`
+	// Enabled settings policies scoped to a group of the entity, and the
+	// default settings policy of its entity type
+	SettingsPolicies []SettingsPolicy `json:"settingsPolicies"`
+	// Settings policies providing the effective value of at least one setting
+	WinningSettingsPolicies []BaseApiDTO `json:"winningSettingsPolicies"`
+}
+
+// Retrives the settings applied to the entity with the provided uuid
+func (c *Client) GetEntitySettings(reqOpts EntitySettingsRequest) (EntitySettings, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + "/settings", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: withQueryParameters(reqOpts.CommonReqOptions, map[string]string{
+			"include_settingspolicies": strconv.FormatBool(reqOpts.IncludeSettingsPolicies)})})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var settings EntitySettings
+	if err := json.Unmarshal(restResp, &settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Retrives the placement policies which apply to the entity with the provided uuid
+func (c *Client) GetEntityPlacementPolicies(reqOpts EntityRequest) ([]PlacementPolicy, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + "/policies", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []PlacementPolicy
+	if err := json.Unmarshal(restResp, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Retrives the placement policies and the settings policies whose scope
+// includes the entity with the provided uuid, along with the winning settings
+// policies providing the effective value of its settings
+func (c *Client) GetEntityPolicies(reqOpts EntityRequest) (*EntityPolicies, error) {
+
+	placementPolicies, err := c.GetEntityPlacementPolicies(reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	settingsPolicies, err := c.getEntitySettingsPolicies(reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := c.GetEntitySettings(EntitySettingsRequest{Uuid: reqOpts.Uuid, IncludeSettingsPolicies: true,
+		CommonReqOptions: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntityPolicies{PlacementPolicies: placementPolicies, SettingsPolicies: settingsPolicies,
+		WinningSettingsPolicies: settings.Policies()}, nil
+}
+
+// Returns the enabled settings policies scoped to a group of the entity, and
+// the default settings policy of its entity type, which applies to all of them
+func (c *Client) getEntitySettingsPolicies(reqOpts EntityRequest) ([]SettingsPolicy, error) {
+
+	entity, err := c.GetEntity(reqOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/entities/" + reqOpts.Uuid + "/groups", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	if err := json.Unmarshal(restResp, &groups); err != nil {
+		return nil, err
+	}
+	groupUuids := map[string]bool{}
+	for _, group := range groups {
+		groupUuids[group.UUID] = true
+	}
+
+	policies, err := c.GetSettingsPolicies(SettingsPoliciesRequest{CommonReqParams: reqOpts.CommonReqOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	scoped := []SettingsPolicy{}
+	for _, policy := range policies {
+		if policy.Disabled || policy.EntityType != entity.ClassName {
+			continue
+		}
+		if policy.Default || slices.ContainsFunc(policy.Scopes, func(scope Group) bool { return groupUuids[scope.UUID] }) {
+			scoped = append(scoped, policy)
+		}
+	}
+
+	return scoped, nil
+}
+
+// Flattens the settings into their effective values
+func (s EntitySettings) Effective() []EffectiveSetting {
+	var effective []EffectiveSetting
+	for _, manager := range s {
+		for _, setting := range manager.Settings {
+			effective = append(effective, EffectiveSetting{
+				ManagerUuid:  manager.UUID,
+				SettingUuid:  setting.UUID,
+				DisplayName:  setting.DisplayName,
+				Value:        setting.Value,
+				DefaultValue: setting.DefaultValue,
+				Policy:       setting.ActiveSettingsPolicy,
+			})
+		}
+	}
+	return effective
+}
+
+// Returns the effective value of the setting with the provided uuid from the given manager
+func (s EntitySettings) Setting(managerUuid, settingUuid string) (*EffectiveSetting, bool) {
+	for _, setting := range s.Effective() {
+		if setting.ManagerUuid == managerUuid && setting.SettingUuid == settingUuid {
+			return &setting, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the effective automation mode of an action type, such as "resize"
+// or "move", and the policy it comes from
+func (s EntitySettings) ActionMode(actionSetting string) (*EffectiveSetting, bool) {
+	return s.Setting(AutomationManager, actionSetting)
+}
+
+// Returns the distinct settings policies providing the effective value of the settings
+func (s EntitySettings) Policies() []BaseApiDTO {
+	var policies []BaseApiDTO
+	seen := map[string]bool{}
+	for _, setting := range s.Effective() {
+		if setting.Policy == nil || seen[setting.Policy.UUID] {
+			continue
+		}
+		seen[setting.Policy.UUID] = true
+		policies = append(policies, *setting.Policy)
+	}
+	return policies
+}
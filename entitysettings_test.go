@@ -0,0 +1,161 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEntitySettings(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetEntitySettings.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/entities/75941320319680/settings", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("include_settingspolicies"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	settings, err := client.GetEntitySettings(EntitySettingsRequest{Uuid: "75941320319680", IncludeSettingsPolicies: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(settings.Effective()))
+
+	resize, ok := settings.ActionMode("resize")
+	assert.True(t, ok)
+	assert.Equal(t, string(RECOMMEND), resize.Value)
+	assert.Equal(t, "MANUAL", resize.DefaultValue)
+	assert.Equal(t, "Production VMs - Recommend only", resize.Policy.DisplayName)
+
+	increment, ok := settings.Setting("capacitymanager", "usedIncrement_VMEM")
+	assert.True(t, ok)
+	assert.Equal(t, "1024.0", increment.Value)
+
+	_, ok = settings.ActionMode("suspend")
+	assert.False(t, ok)
+}
+
+func TestGetEntityPolicies(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock responses from the Turbonomic API
+	settingsResponse, err := os.ReadFile("./testfiles/GetEntitySettings.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+	policyResponse, err := os.ReadFile("./testfiles/GetPlacementPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		var response []byte
+		switch r.URL.Path {
+		case "/entities/75941320319680/policies":
+			response = append(append([]byte("["), policyResponse...), ']')
+		case "/entities/75941320319680/settings":
+			assert.Equal(t, "true", r.URL.Query().Get("include_settingspolicies"))
+			response = settingsResponse
+		case "/entities/75941320319680":
+			response = []byte(`{"uuid":"75941320319680","displayName":"vm-1","className":"VirtualMachine"}`)
+		case "/entities/75941320319680/groups":
+			response = []byte(`[{"uuid":"285024588762240","displayName":"Production VMs"},{"uuid":"285024588762241","displayName":"Web VMs"}]`)
+		case "/settingspolicies":
+			// The overridden policy of Web VMs is scoped to the entity without winning any setting
+			response = []byte(`[
+				{"uuid":"637195409011111","displayName":"Production VMs - Recommend only","entityType":"VirtualMachine",
+					"scopes":[{"uuid":"285024588762240"}]},
+				{"uuid":"637195409033333","displayName":"Web VMs - Automatic","entityType":"VirtualMachine",
+					"scopes":[{"uuid":"285024588762241"}]},
+				{"uuid":"637195409044444","displayName":"Test VMs","entityType":"VirtualMachine",
+					"scopes":[{"uuid":"285024588762299"}]},
+				{"uuid":"637195409055555","displayName":"Disabled","entityType":"VirtualMachine","disabled":true,
+					"scopes":[{"uuid":"285024588762240"}]},
+				{"uuid":"637195409022222","displayName":"Virtual Machine Defaults","entityType":"VirtualMachine","default":true},
+				{"uuid":"637195409066666","displayName":"Host Defaults","entityType":"PhysicalMachine","default":true}]`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(response); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	policies, err := client.GetEntityPolicies(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policies.PlacementPolicies))
+	assert.Equal(t, []BaseApiDTO{
+		{UUID: "637195409011111", DisplayName: "Production VMs - Recommend only", ClassName: "SettingsPolicy"},
+		{UUID: "637195409022222", DisplayName: "Virtual Machine Defaults", ClassName: "SettingsPolicy"},
+	}, policies.WinningSettingsPolicies)
+
+	var scoped []string
+	for _, policy := range policies.SettingsPolicies {
+		scoped = append(scoped, policy.UUID)
+	}
+	assert.Equal(t, []string{"637195409011111", "637195409033333", "637195409022222"}, scoped)
+}
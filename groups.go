@@ -0,0 +1,249 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// BaseApiDTO is the minimal reference to a Turbonomic object returned inside
+// other API objects
+type BaseApiDTO struct {
+	UUID        string `json:"uuid,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	ClassName   string `json:"className,omitempty"`
+}
+
+// Parameters for retriving a group from Turbonomic's API
+type GroupRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for searching groups by name using Turbonomic's API
+type GroupSearchRequest struct {
+	Name            string
+	GroupType       string
+	CaseSensitive   bool
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a group using Turbonomic's API
+type GroupInputRequest struct {
+	Uuid            string
+	Group           GroupInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API groups
+type GroupInput struct {
+	DisplayName     string     `json:"displayName"`
+	GroupType       string     `json:"groupType"`
+	IsStatic        bool       `json:"isStatic"`
+	MemberUuidList  []string   `json:"memberUuidList,omitempty"`
+	CriteriaList    []Criteria `json:"criteriaList,omitempty"`
+	LogicalOperator string     `json:"logicalOperator,omitempty"`
+	Scope           []string   `json:"scope,omitempty"`
+}
+
+// Group returned by Turbonomic's API
+type Group struct {
+	UUID              string              `json:"uuid"`
+	DisplayName       string              `json:"displayName"`
+	ClassName         string              `json:"className"`
+	GroupType         string              `json:"groupType"`
+	GroupClassName    string              `json:"groupClassName"`
+	EnvironmentType   string              `json:"environmentType"`
+	IsStatic          bool                `json:"isStatic"`
+	LogicalOperator   string              `json:"logicalOperator"`
+	MemberUuidList    []string            `json:"memberUuidList"`
+	CriteriaList      []Criteria          `json:"criteriaList"`
+	MembersCount      int                 `json:"membersCount"`
+	EntitiesCount     int                 `json:"entitiesCount"`
+	ActiveEntities    int                 `json:"activeEntitiesCount"`
+	Severity          string              `json:"severity"`
+	SeverityBreakdown map[string]int      `json:"severityBreakdown"`
+	Readonly          bool                `json:"readonly"`
+	Source            *BaseApiDTO         `json:"source,omitempty"`
+	Tags              map[string][]string `json:"tags,omitempty"`
+}
+
+// Builds the input for a static group containing the provided member uuids
+func NewStaticGroup(displayName, groupType string, memberUuids []string) GroupInput {
+	return GroupInput{
+		DisplayName:    displayName,
+		GroupType:      groupType,
+		IsStatic:       true,
+		MemberUuidList: memberUuids,
+	}
+}
+
+// Builds the input for a dynamic group whose members are resolved from the
+// provided criteria
+func NewDynamicGroup(displayName, groupType, logicalOperator string, criteria []Criteria) GroupInput {
+	return GroupInput{
+		DisplayName:     displayName,
+		GroupType:       groupType,
+		IsStatic:        false,
+		CriteriaList:    criteria,
+		LogicalOperator: logicalOperator,
+	}
+}
+
+// Retrives all groups visible to the user
+func (c *Client) GetGroups(reqParams CommonReqParams) ([]Group, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/groups", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	if err := json.Unmarshal(restResp, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Retrives a group based on its provided uuid
+func (c *Client) GetGroup(groupReq GroupRequest) (*Group, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/groups/" + groupReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: groupReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var group Group
+	if err := json.Unmarshal(restResp, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Searches groups by name, optionally restricted to groups of the given member type
+func (c *Client) SearchGroups(searchReq GroupSearchRequest) ([]Group, error) {
+
+	filterType, err := c.getFilterType("Group", searchReq.CommonReqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	searchCriteria := SearchDTO{
+		LogicalOperator: "AND",
+		ClassName:       "Group",
+		CriteriaList: []Criteria{
+			{
+				CaseSensitive: searchReq.CaseSensitive,
+				ExpType:       "EQ",
+				ExpVal:        searchReq.Name,
+				FilterType:    filterType,
+			},
+		},
+	}
+	if searchReq.GroupType != "" {
+		searchCriteria.CriteriaList = append(searchCriteria.CriteriaList, Criteria{
+			CaseSensitive: true,
+			ExpType:       "EQ",
+			ExpVal:        searchReq.GroupType,
+			FilterType:    "groupsByGroupType",
+		})
+	}
+
+	var groups []Group
+	if err := c.search(searchCriteria, searchReq.CommonReqParams, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Creates a static or dynamic group
+func (c *Client) CreateGroup(groupReq GroupInputRequest) (*Group, error) {
+	return c.sendGroup("POST", "/groups", groupReq)
+}
+
+// Updates the group with the provided uuid
+func (c *Client) UpdateGroup(groupReq GroupInputRequest) (*Group, error) {
+	return c.sendGroup("PUT", "/groups/"+groupReq.Uuid, groupReq)
+}
+
+// Deletes the group with the provided uuid
+func (c *Client) DeleteGroup(groupReq GroupRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/groups/" + groupReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: groupReq.CommonReqParams})
+
+	return err
+}
+
+// Retrives the direct members of a group, which may be entities or other groups
+func (c *Client) GetGroupMembers(groupReq GroupRequest) ([]EntityResults, error) {
+	return c.getGroupEntities(groupReq, "/members")
+}
+
+// Retrives the entities a group resolves to
+func (c *Client) GetGroupEntities(groupReq GroupRequest) ([]EntityResults, error) {
+	return c.getGroupEntities(groupReq, "/entities")
+}
+
+// Retrives the leaf entities of a group, expanding any nested groups
+func (c *Client) GetGroupLeafEntities(groupReq GroupRequest) ([]EntityResults, error) {
+	return c.getGroupEntities(groupReq, "/leafEntities")
+}
+
+func (c *Client) sendGroup(method, urlPath string, groupReq GroupInputRequest) (*Group, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(groupReq.Group); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: groupReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var group Group
+	if err := json.Unmarshal(restResp, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (c *Client) getGroupEntities(groupReq GroupRequest, suffix string) ([]EntityResults, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/groups/" + groupReq.Uuid + suffix, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: groupReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []EntityResults
+	if err := json.Unmarshal(restResp, &entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
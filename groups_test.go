@@ -0,0 +1,296 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// For Integrations tests, the GroupTests struct is referenced from testdata.go which needs to be created based on testdata.go.template.
+// Integrations tests will only run if the environment variable `INTEGRATION` is set.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGroup(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetGroup.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	// Create a test server with the mock response
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/groups/285024588762240", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Errorf("failed to write header: %s", err.Error())
+			t.FailNow()
+		}
+	}))
+	defer ts.Close()
+
+	// Set the base URL for the client
+	client.BaseURL = ts.URL
+
+	// Call the GetGroup function
+	group, err := client.GetGroup(GroupRequest{Uuid: "285024588762240"})
+
+	// Assert that the function returned the expected result and no error
+	assert.NoError(t, err)
+	assert.Equal(t, "AppInfra VMs", group.DisplayName)
+	assert.Equal(t, "VirtualMachine", group.GroupType)
+	assert.True(t, group.IsStatic)
+	assert.Equal(t, []string{"75941320319680", "75941320319681"}, group.MemberUuidList)
+	assert.Equal(t, []string{"AppInfra_Integrations"}, group.Tags["Turbo_Team"])
+}
+
+func TestCreateGroup(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	mockResponse, err := os.ReadFile("./testfiles/GetGroup.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	tests := []struct {
+		name         string
+		input        GroupInput
+		expectedBody string
+	}{
+		{
+			name:  "static group",
+			input: NewStaticGroup("AppInfra VMs", "VirtualMachine", []string{"75941320319680", "75941320319681"}),
+			expectedBody: "{\"displayName\":\"AppInfra VMs\",\"groupType\":\"VirtualMachine\",\"isStatic\":true," +
+				"\"memberUuidList\":[\"75941320319680\",\"75941320319681\"]}\n",
+		},
+		{
+			name: "dynamic group",
+			input: NewDynamicGroup("AppInfra VMs", "VirtualMachine", "AND", []Criteria{
+				{ExpType: "RXEQ", ExpVal: "test-vm.*", FilterType: "vmsByName"}}),
+			expectedBody: "{\"displayName\":\"AppInfra VMs\",\"groupType\":\"VirtualMachine\",\"isStatic\":false," +
+				"\"criteriaList\":[{\"caseSensitive\":false,\"expType\":\"RXEQ\",\"expVal\":\"test-vm.*\",\"filterType\":\"vmsByName\"}]," +
+				"\"logicalOperator\":\"AND\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/groups", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, tt.expectedBody, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write(mockResponse); err != nil {
+					t.Fail()
+					t.Log(err)
+				}
+			}))
+			defer ts.Close()
+
+			client.BaseURL = ts.URL
+
+			group, err := client.CreateGroup(GroupInputRequest{Group: tt.input})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "285024588762240", group.UUID)
+		})
+	}
+}
+
+func TestGetGroupMembers(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetGroupMembers.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	// Create a test server with the mock response
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/groups/285024588762240/members", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	members, err := client.GetGroupMembers(GroupRequest{Uuid: "285024588762240"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(members))
+	assert.Equal(t, "test-vm", members[0].DisplayName)
+	assert.Equal(t, "MINOR", members[1].Severity)
+}
+
+func TestDeleteGroup(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/groups/285024588762240", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte("true")); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	err := client.DeleteGroup(GroupRequest{Uuid: "285024588762240"})
+	assert.NoError(t, err)
+}
+
+func TestSearchGroups(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/search/criteria":
+			// The name filter of groups is taken from the discovered criteria
+			response = `[{"filterType":"groupsByDisplayName","entityType":"Group","elements":"displayName"},
+				{"filterType":"groupsByGroupType","entityType":"Group"}]`
+		case "/search":
+			assert.Equal(t, "POST", r.Method)
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, `{"criteriaList":[{"caseSensitive":false,"expType":"EQ","expVal":"AppInfra VMs","filterType":"groupsByDisplayName"},`+
+				`{"caseSensitive":true,"expType":"EQ","expVal":"VirtualMachine","filterType":"groupsByGroupType"}],`+
+				`"logicalOperator":"AND","className":"Group"}`+"\n", string(body))
+			response = `[{"uuid":"285024588762240","displayName":"AppInfra VMs","groupType":"VirtualMachine"}]`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	groups, err := client.SearchGroups(GroupSearchRequest{Name: "AppInfra VMs", GroupType: "VirtualMachine"})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(groups)) {
+		assert.Equal(t, "285024588762240", groups[0].UUID)
+	}
+}
+
+func TestGroupsIntegration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("skipping integration tests, to run set environment variable INTEGRATION")
+	}
+	newClientOpts := ClientParameters{Hostname: TurboHost, Username: TurboUser, Password: TurboPass, Skipverify: DoNotVerify}
+
+	c, err := NewClient(&newClientOpts)
+	if err != nil {
+		t.Errorf("failed to create client: %s", err.Error())
+		t.FailNow()
+	}
+
+	for _, tt := range GroupTests {
+		group, err := c.GetGroup(GroupRequest{Uuid: tt.uuid})
+		if err != nil {
+			t.Errorf("error: %s", err.Error())
+			t.FailNow()
+		}
+		if group.DisplayName != tt.displayName || group.GroupType != tt.groupType {
+			t.Errorf("error: got %s/%s expected %s/%s", group.DisplayName, group.GroupType, tt.displayName, tt.groupType)
+		}
+
+		members, err := c.GetGroupMembers(GroupRequest{Uuid: tt.uuid})
+		if err != nil {
+			t.Errorf("error: %s", err.Error())
+			t.FailNow()
+		}
+		if len(members) != tt.membersCount {
+			t.Errorf("received %d members, wanted %d", len(members), tt.membersCount)
+		}
+	}
+}
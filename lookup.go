@@ -0,0 +1,189 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Search filters matching entities by IP address
+var ipFilterMap = map[EntityType]string{
+	EntityTypeVM: "vmsByIP",
+}
+
+// Parameters for finding an entity by an identifier of its cloud or
+// hypervisor, such as an instance id, resource id or IP address
+type EntityLookupRequest struct {
+	Value string
+	// Defaults to virtual machines
+	EntityTypes []EntityType
+	// Group or business account the lookup is restricted to
+	ScopeUuid       string
+	CommonReqParams CommonReqParams
+}
+
+// Error returned when an identifier matches more than one entity
+type AmbiguousEntityError struct {
+	Kind    string
+	Value   string
+	Matches SearchResults
+}
+
+func (e *AmbiguousEntityError) Error() string {
+	return fmt.Sprintf("%s %s matches %d entities: %s", e.Kind, e.Value, len(e.Matches),
+		strings.Join(e.Matches.Uuids(), ", "))
+}
+
+// Entity identifier matched by a lookup
+type entityLookup struct {
+	kind        string
+	filterTypes map[EntityType]string
+	// Entity types holding the identifier, any type when empty
+	entityTypes []EntityType
+	matches     func(SearchResult) bool
+}
+
+// Retrives the entity with the provided vendor id, the id of the entity in
+// its cloud or hypervisor, such as vm-123 or an EC2 instance id. Search
+// criteria do not filter on vendor ids, so every entity of the requested
+// types in scope is read and ScopeUuid is required.
+func (c *Client) FindEntityByVendorID(lookupReq EntityLookupRequest) (*SearchResult, error) {
+	return c.findEntity(lookupReq, entityLookup{
+		kind: "vendor id",
+		matches: func(result SearchResult) bool {
+			for _, vendorId := range result.VendorIds {
+				if vendorId == lookupReq.Value {
+					return true
+				}
+			}
+			return false
+		},
+	})
+}
+
+// Retrives the virtual machine with the provided cloud resource id, such as
+// an Azure resource id, which is matched regardless of its case. Search
+// criteria do not filter on resource ids, so ScopeUuid is required.
+func (c *Client) FindEntityByResourceID(lookupReq EntityLookupRequest) (*SearchResult, error) {
+	return c.findEntity(lookupReq, entityLookup{
+		kind:        "resource id",
+		entityTypes: []EntityType{EntityTypeVM},
+		matches: func(result SearchResult) bool {
+			return strings.EqualFold(result.Aspects.VirtualMachineAspect.ResourceID, lookupReq.Value)
+		},
+	})
+}
+
+// Retrives the virtual machine with the provided IP address. ScopeUuid is
+// required when the server has no search filter on IP addresses.
+func (c *Client) FindEntityByIP(lookupReq EntityLookupRequest) (*SearchResult, error) {
+
+	ip := net.ParseIP(lookupReq.Value)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid ip address %s", lookupReq.Value)
+	}
+
+	return c.findEntity(lookupReq, entityLookup{
+		kind:        "ip address",
+		filterTypes: ipFilterMap,
+		entityTypes: []EntityType{EntityTypeVM},
+		matches: func(result SearchResult) bool {
+			return slices.ContainsFunc(result.Aspects.VirtualMachineAspect.IP, func(address string) bool {
+				return ip.Equal(net.ParseIP(address))
+			})
+		},
+	})
+}
+
+// Searches the entities with the filter of the lookup where Turbonomic
+// provides one, and scans every page of the entities in scope otherwise,
+// which requires a scope so that the whole inventory is never read. Results
+// are checked against the identifier, as filters also match partial values.
+func (c *Client) findEntity(lookupReq EntityLookupRequest, lookup entityLookup) (*SearchResult, error) {
+
+	if lookupReq.Value == "" {
+		return nil, fmt.Errorf("%s is required", lookup.kind)
+	}
+	entityTypes := lookupReq.EntityTypes
+	if len(entityTypes) == 0 {
+		entityTypes = []EntityType{EntityTypeVM}
+	}
+	for _, entityType := range entityTypes {
+		if len(lookup.entityTypes) > 0 && !slices.Contains(lookup.entityTypes, entityType) {
+			return nil, fmt.Errorf("%s lookup not supported for entity type %s", lookup.kind, entityType)
+		}
+	}
+
+	filterTypes := map[EntityType]string{}
+	for _, entityType := range entityTypes {
+		if filterType := lookup.filterTypes[entityType]; filterType != "" && c.hasSearchFilter(filterType, lookupReq.CommonReqParams) {
+			filterTypes[entityType] = filterType
+		} else if lookupReq.ScopeUuid == "" {
+			return nil, fmt.Errorf("a scope is required to look up an entity of type %s by %s", entityType, lookup.kind)
+		}
+	}
+
+	// Resource ids and IP addresses are held by the virtual machine aspect
+	reqParams := withQueryParameters(lookupReq.CommonReqParams, map[string]string{"aspect_names": VirtualMachineAspectName})
+
+	matches := SearchResults{}
+	for _, entityType := range entityTypes {
+		builder := NewSearch(entityType)
+		if lookupReq.ScopeUuid != "" {
+			builder.InScope(lookupReq.ScopeUuid)
+		}
+		if filterType := filterTypes[entityType]; filterType != "" {
+			builder.Where(filterType, EXP_EQ, lookupReq.Value)
+		}
+
+		search, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		results, err := c.searchAllEntities(search, reqParams)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range results {
+			if lookup.matches(result) && !slices.ContainsFunc(matches, func(m SearchResult) bool { return m.UUID == result.UUID }) {
+				matches = append(matches, result)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("entity with %s %s not found", lookup.kind, lookupReq.Value)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, &AmbiguousEntityError{Kind: lookup.kind, Value: lookupReq.Value, Matches: matches}
+	}
+}
+
+// Checks that the filter type is among the discovered search criteria, or
+// assumes it is available when discovery is unavailable
+func (c *Client) hasSearchFilter(filterType string, reqParams CommonReqParams) bool {
+	criteria, err := c.GetSearchCriteria(reqParams)
+	if err != nil {
+		return true
+	}
+	return slices.ContainsFunc(criteria, func(criterion SearchCriterion) bool { return criterion.FilterType == filterType })
+}
@@ -0,0 +1,176 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lookupEntities = `[
+	{"uuid":"1","displayName":"vm-1","className":"VirtualMachine","vendorIds":{"aws":"i-0abc"},
+		"aspects":{"virtualMachineAspect":{"ip":["10.0.0.1"],"resourceId":"/subscriptions/s/resourceGroups/RG/providers/Microsoft.Compute/virtualMachines/vm-1"}}},
+	{"uuid":"2","displayName":"vm-2","className":"VirtualMachine","vendorIds":{"aws":"i-0def"},
+		"aspects":{"virtualMachineAspect":{"ip":["10.0.0.2","10.0.0.1"]}}},
+	{"uuid":"3","displayName":"vm-3","className":"VirtualMachine","vendorIds":{"vc1":"vm-12"},
+		"aspects":{"virtualMachineAspect":{"ip":["10.0.0.12"]}}}
+]`
+
+func TestFindEntity(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	var searches []SearchDTO
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/criteria" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "virtualMachineAspect", r.URL.Query().Get("aspect_names"))
+
+		var search struct {
+			SearchDTO
+			Scope []string `json:"scope"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&search))
+		search.Scopes = search.Scope
+		searches = append(searches, search.SearchDTO)
+
+		// The last entity is returned in a second page
+		var entities []json.RawMessage
+		assert.NoError(t, json.Unmarshal([]byte(lookupEntities), &entities))
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("X-Next-Cursor", "2")
+			entities = entities[:2]
+		} else {
+			assert.Equal(t, "2", r.URL.Query().Get("cursor"))
+			entities = entities[2:]
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(entities); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	entity, err := client.FindEntityByVendorID(EntityLookupRequest{Value: "vm-12", ScopeUuid: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", entity.UUID)
+
+	// Lookups scanning entities are refused without a scope
+	searches = nil
+	_, err = client.FindEntityByVendorID(EntityLookupRequest{Value: "vm-12"})
+	assert.EqualError(t, err, "a scope is required to look up an entity of type VirtualMachine by vendor id")
+	assert.Empty(t, searches)
+
+	searches = nil
+	entity, err = client.FindEntityByVendorID(EntityLookupRequest{Value: "i-0def", ScopeUuid: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", entity.UUID)
+	assert.Equal(t, "VirtualMachine", searches[0].ClassName)
+	assert.Equal(t, []Criteria{}, searches[0].CriteriaList)
+	assert.Equal(t, []string{"123"}, searches[0].Scopes)
+
+	entity, err = client.FindEntityByResourceID(EntityLookupRequest{
+		Value: "/subscriptions/s/resourcegroups/rg/providers/Microsoft.Compute/virtualMachines/vm-1", ScopeUuid: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", entity.UUID)
+
+	_, err = client.FindEntityByResourceID(EntityLookupRequest{Value: "/subscriptions/s"})
+	assert.EqualError(t, err, "a scope is required to look up an entity of type VirtualMachine by resource id")
+
+	searches = nil
+	entity, err = client.FindEntityByIP(EntityLookupRequest{Value: "10.0.0.12"})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", entity.UUID)
+	assert.Equal(t, []Criteria{{ExpType: "EQ", ExpVal: "10.0.0.12", FilterType: "vmsByIP"}}, searches[0].CriteriaList)
+
+	_, err = client.FindEntityByIP(EntityLookupRequest{Value: "10.0.0.1"})
+	var ambiguous *AmbiguousEntityError
+	if assert.True(t, errors.As(err, &ambiguous)) {
+		assert.Equal(t, []string{"1", "2"}, ambiguous.Matches.Uuids())
+	}
+	assert.EqualError(t, err, "ip address 10.0.0.1 matches 2 entities: 1, 2")
+
+	_, err = client.FindEntityByVendorID(EntityLookupRequest{Value: "i-0123", ScopeUuid: "123"})
+	assert.EqualError(t, err, "entity with vendor id i-0123 not found")
+
+	_, err = client.FindEntityByIP(EntityLookupRequest{Value: "10.0.0"})
+	assert.EqualError(t, err, "invalid ip address 10.0.0")
+
+	_, err = client.FindEntityByResourceID(EntityLookupRequest{Value: "/subscriptions/s", EntityTypes: []EntityType{EntityTypeDatabase}})
+	assert.EqualError(t, err, "resource id lookup not supported for entity type Database")
+}
+
+func TestFindEntityByIPWithoutFilter(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
+
+		// The discovered criteria have no IP filter, so entities in scope are scanned
+		var search struct {
+			SearchDTO
+			Scope []string `json:"scope"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&search))
+		assert.Equal(t, []Criteria{}, search.CriteriaList)
+		assert.Equal(t, []string{"123"}, search.Scope)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(lookupEntities)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	entity, err := client.FindEntityByIP(EntityLookupRequest{Value: "10.0.0.2", ScopeUuid: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", entity.UUID)
+
+	_, err = client.FindEntityByIP(EntityLookupRequest{Value: "10.0.0.2"})
+	assert.EqualError(t, err, "a scope is required to look up an entity of type VirtualMachine by ip address")
+}
@@ -0,0 +1,316 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Hours used to convert hourly prices into monthly costs
+const HoursPerMonth = 730
+
+// Licensing models of migrated virtual machines, read from the operating
+// system of the projected virtual machine
+const (
+	LicenseIncluded     = "LICENSE_INCLUDED"
+	BringYourOwnLicense = "BYOL"
+)
+
+const (
+	cloudMigrationType   = "CLOUD_MIGRATION"
+	migrationEntityType  = "VirtualMachine"
+	matchToSourceSetting = "matchToSource"
+)
+
+// OS migration settings enabling bring your own license for each OS family
+var byolSettings = []string{"linuxByol", "rhelByol", "slesByol", "windowsByol"}
+
+// Parameters for planning the migration of on-prem virtual machines to a cloud region
+type MigrationRequest struct {
+	DisplayName string
+	// Group of virtual machines to migrate. When empty, a static group is
+	// created from SourceEntityUuids.
+	SourceGroupUuid        string
+	SourceEntityUuids      []string
+	DestinationRegionUuid  string
+	DestinationAccountUuid string
+	BringYourOwnLicense    bool
+	PollInterval           time.Duration
+	Timeout                time.Duration
+	CommonReqParams        CommonReqParams
+}
+
+// Result of a cloud migration plan
+type MigrationReport struct {
+	PlanMarketUuid         string `json:"planMarketUuid"`
+	LiftAndShiftMarketUuid string `json:"liftAndShiftMarketUuid,omitempty"`
+	ScenarioUuid           string `json:"scenarioUuid"`
+	// Empty when the source group was created from SourceEntityUuids
+	SourceGroupUuid         string        `json:"sourceGroupUuid,omitempty"`
+	DestinationRegionUuid   string        `json:"destinationRegionUuid"`
+	DestinationAccountUuid  string        `json:"destinationAccountUuid,omitempty"`
+	VirtualMachines         []VMMigration `json:"virtualMachines"`
+	LiftAndShiftMonthlyCost float64       `json:"liftAndShiftMonthlyCost"`
+	OptimizedMonthlyCost    float64       `json:"optimizedMonthlyCost"`
+}
+
+// Error of a cloud migration that failed once its scenario was created,
+// holding the uuids of the scenario and plan market to clean up
+type MigrationError struct {
+	ScenarioUuid   string
+	PlanMarketUuid string
+	Err            error
+}
+
+func (e *MigrationError) Error() string {
+	if e.PlanMarketUuid == "" {
+		return fmt.Sprintf("cloud migration of scenario %s: %s", e.ScenarioUuid, e.Err)
+	}
+	return fmt.Sprintf("cloud migration of scenario %s, plan %s: %s", e.ScenarioUuid, e.PlanMarketUuid, e.Err)
+}
+
+func (e *MigrationError) Unwrap() error {
+	return e.Err
+}
+
+// Migration outcome of a single virtual machine
+type VMMigration struct {
+	UUID                    string   `json:"uuid"`
+	DisplayName             string   `json:"displayName"`
+	ComputeTier             string   `json:"computeTier"`
+	StorageTiers            []string `json:"storageTiers"`
+	LiftAndShiftMonthlyCost float64  `json:"liftAndShiftMonthlyCost"`
+	OptimizedMonthlyCost    float64  `json:"optimizedMonthlyCost"`
+	// Operating system of the virtual machine projected by the optimized
+	// plan, such as WINDOWS_BYOL, and its licensing model. Both are empty
+	// when the plan does not report the operating system.
+	OS           string `json:"os,omitempty"`
+	LicenseModel string `json:"licenseModel,omitempty"`
+}
+
+// Plans the migration of a group of virtual machines to a cloud region, waits
+// for the plan to complete and reports the placement and cost of each virtual
+// machine. The plan is kept so that it can be reviewed in the UI; remove it
+// with DeletePlan once it is no longer needed. A group created from
+// SourceEntityUuids is deleted once the report is produced. Failures after the
+// scenario is created are returned as a *MigrationError holding the uuids of
+// the scenario and plan left on the server.
+func (c *Client) RunCloudMigration(ctx context.Context, migrationReq MigrationRequest) (*MigrationReport, error) {
+
+	if migrationReq.DestinationRegionUuid == "" {
+		return nil, errors.New("a destination region is required for a cloud migration")
+	}
+
+	sourceGroup := migrationReq.SourceGroupUuid
+	if sourceGroup == "" {
+		if len(migrationReq.SourceEntityUuids) == 0 {
+			return nil, errors.New("a source group or source entities are required for a cloud migration")
+		}
+		group, err := c.CreateGroup(GroupInputRequest{
+			Group: NewStaticGroup(migrationReq.DisplayName+" - source", migrationEntityType,
+				migrationReq.SourceEntityUuids),
+			CommonReqParams: migrationReq.CommonReqParams,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sourceGroup = group.UUID
+		defer c.deleteMigrationGroup(sourceGroup, migrationReq.CommonReqParams)
+	}
+
+	scenario, err := c.CreateScenario(ScenarioInputRequest{
+		Scenario:        buildMigrationScenario(migrationReq, sourceGroup),
+		CommonReqParams: migrationReq.CommonReqParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	migrationErr := &MigrationError{ScenarioUuid: scenario.UUID}
+	market, err := c.RunScenario(RunScenarioRequest{ScenarioUuid: scenario.UUID,
+		CommonReqParams: migrationReq.CommonReqParams})
+	if err != nil {
+		migrationErr.Err = err
+		return nil, migrationErr
+	}
+	migrationErr.PlanMarketUuid = market.UUID
+
+	market, err = c.WaitForPlan(ctx, WaitForPlanRequest{
+		Uuid:            market.UUID,
+		PollInterval:    migrationReq.PollInterval,
+		Timeout:         migrationReq.Timeout,
+		CommonReqParams: migrationReq.CommonReqParams,
+	})
+	if err != nil {
+		migrationErr.Err = err
+		return nil, migrationErr
+	}
+
+	report := &MigrationReport{
+		PlanMarketUuid:         market.UUID,
+		ScenarioUuid:           scenario.UUID,
+		SourceGroupUuid:        migrationReq.SourceGroupUuid,
+		DestinationRegionUuid:  migrationReq.DestinationRegionUuid,
+		DestinationAccountUuid: migrationReq.DestinationAccountUuid,
+	}
+	if len(market.RelatedPlanMarkets) > 0 {
+		report.LiftAndShiftMarketUuid = market.RelatedPlanMarkets[0].UUID
+	}
+
+	members, err := c.GetGroupMembers(GroupRequest{Uuid: sourceGroup, CommonReqParams: migrationReq.CommonReqParams})
+	if err != nil {
+		migrationErr.Err = err
+		return nil, migrationErr
+	}
+
+	optimized, err := c.getMigrationPlacements(report.PlanMarketUuid, migrationReq.CommonReqParams)
+	if err != nil {
+		migrationErr.Err = err
+		return nil, migrationErr
+	}
+	liftAndShift := map[string]*VMMigration{}
+	if report.LiftAndShiftMarketUuid != "" {
+		if liftAndShift, err = c.getMigrationPlacements(report.LiftAndShiftMarketUuid, migrationReq.CommonReqParams); err != nil {
+			migrationErr.Err = err
+			return nil, migrationErr
+		}
+	}
+
+	for _, member := range members {
+		vm := VMMigration{UUID: member.UUID, DisplayName: member.DisplayName}
+		if placement, ok := optimized[member.UUID]; ok {
+			vm.ComputeTier = placement.ComputeTier
+			vm.StorageTiers = placement.StorageTiers
+			vm.OptimizedMonthlyCost = placement.OptimizedMonthlyCost
+			vm.OS = placement.OS
+			vm.LicenseModel = placement.LicenseModel
+		}
+		if placement, ok := liftAndShift[member.UUID]; ok {
+			vm.LiftAndShiftMonthlyCost = placement.OptimizedMonthlyCost
+		}
+		report.LiftAndShiftMonthlyCost += vm.LiftAndShiftMonthlyCost
+		report.OptimizedMonthlyCost += vm.OptimizedMonthlyCost
+		report.VirtualMachines = append(report.VirtualMachines, vm)
+	}
+
+	return report, nil
+}
+
+// Deletes the group created for the source entities of a migration. The
+// migration outcome does not depend on it, so a failure is only logged.
+func (c *Client) deleteMigrationGroup(groupUuid string, reqParams CommonReqParams) {
+	if err := c.DeleteGroup(GroupRequest{Uuid: groupUuid, CommonReqParams: reqParams}); err != nil {
+		c.Logger.Error(c.Ctx, "deleting migration source group "+groupUuid+": "+err.Error())
+	}
+}
+
+// Returns the licensing model of a projected operating system, such as BYOL
+// for WINDOWS_BYOL
+func licenseModel(os string) string {
+	if os == "" {
+		return ""
+	}
+	if strings.Contains(strings.ToUpper(os), BringYourOwnLicense) {
+		return BringYourOwnLicense
+	}
+	return LicenseIncluded
+}
+
+func buildMigrationScenario(migrationReq MigrationRequest, sourceGroup string) Scenario {
+
+	scope := []BaseApiDTO{{UUID: sourceGroup}, {UUID: migrationReq.DestinationRegionUuid}}
+	if migrationReq.DestinationAccountUuid != "" {
+		scope = append(scope, BaseApiDTO{UUID: migrationReq.DestinationAccountUuid})
+	}
+
+	osSettings := []Setting{{UUID: matchToSourceSetting, Value: "true"}}
+	if migrationReq.BringYourOwnLicense {
+		for _, setting := range byolSettings {
+			osSettings = append(osSettings, Setting{UUID: setting, Value: "true"})
+		}
+	}
+
+	return Scenario{
+		DisplayName: migrationReq.DisplayName,
+		Type:        cloudMigrationType,
+		Scope:       scope,
+		TopologyChanges: &TopologyChanges{
+			MigrateList: []MigrateObject{{
+				Source:                      BaseApiDTO{UUID: sourceGroup},
+				Destination:                 BaseApiDTO{UUID: migrationReq.DestinationRegionUuid},
+				DestinationEntityType:       migrationEntityType,
+				RemoveNonMigratingWorkloads: true,
+			}},
+		},
+		ConfigChanges: &ConfigChanges{OsMigrationSettingList: osSettings},
+	}
+}
+
+// Collects the tiers and monthly cost of each migrated virtual machine of a
+// plan market from its projected entities and actions
+func (c *Client) getMigrationPlacements(marketUuid string, reqParams CommonReqParams) (map[string]*VMMigration, error) {
+
+	placements := map[string]*VMMigration{}
+
+	// The operating system of the projected virtual machines tells their licensing
+	entities, err := c.GetMarketEntities(MarketRequest{Uuid: marketUuid,
+		CommonReqParams: withQueryParameters(reqParams, map[string]string{"aspect_names": VirtualMachineAspectName})})
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		if entity.ClassName != migrationEntityType {
+			continue
+		}
+		placement := &VMMigration{
+			UUID:                 entity.UUID,
+			DisplayName:          entity.DisplayName,
+			ComputeTier:          entity.Template.DisplayName,
+			OptimizedMonthlyCost: entity.CostPrice * HoursPerMonth,
+		}
+		if entity.Aspects != nil && entity.Aspects.VirtualMachineAspect != nil {
+			placement.OS = entity.Aspects.VirtualMachineAspect.Os
+			placement.LicenseModel = licenseModel(placement.OS)
+		}
+		placements[entity.UUID] = placement
+	}
+
+	actions, err := c.GetPlanActions(PlanActionsRequest{MarketUuid: marketUuid, CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		placement, ok := placements[action.Target.UUID]
+		if !ok {
+			continue
+		}
+		switch action.NewEntity.ClassName {
+		case "ComputeTier":
+			placement.ComputeTier = action.NewEntity.DisplayName
+		case "StorageTier":
+			if !slices.Contains(placement.StorageTiers, action.NewEntity.DisplayName) {
+				placement.StorageTiers = append(placement.StorageTiers, action.NewEntity.DisplayName)
+			}
+		}
+	}
+
+	return placements, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCloudMigration(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock responses from the Turbonomic API, keyed by request
+	responses := map[string]string{
+		"POST /groups":                        `{"uuid":"1000","displayName":"Wave 1 - source"}`,
+		"POST /scenarios":                     `{"uuid":"2000","displayName":"Wave 1","type":"CLOUD_MIGRATION"}`,
+		"POST /markets/Market/scenarios/2000": `{"uuid":"3000","state":"RUNNING"}`,
+		"GET /markets/3000": `{"uuid":"3000","state":"SUCCEEDED",
+			"relatedPlanMarkets":[{"uuid":"3001","displayName":"Wave 1 - Lift & Shift"}]}`,
+		"GET /groups/1000/members": `[{"uuid":"vm-1","displayName":"db-01","className":"VirtualMachine"},
+			{"uuid":"vm-2","displayName":"web-01","className":"VirtualMachine"}]`,
+		"GET /markets/3000/entities": `[
+			{"uuid":"vm-1","displayName":"db-01","className":"VirtualMachine","costPrice":0.2,"template":{"displayName":"m5.large"},
+				"aspects":{"virtualMachineAspect":{"os":"WINDOWS_BYOL"}}},
+			{"uuid":"vm-2","displayName":"web-01","className":"VirtualMachine","costPrice":0.1,"template":{"displayName":"t3.medium"},
+				"aspects":{"virtualMachineAspect":{"os":"LINUX"}}},
+			{"uuid":"r-1","displayName":"us-east-1","className":"Region"}]`,
+		"POST /markets/3000/actions": `[
+			{"target":{"uuid":"vm-1"},"newEntity":{"displayName":"GP3","className":"StorageTier"}},
+			{"target":{"uuid":"vm-1"},"newEntity":{"displayName":"IO2","className":"StorageTier"}},
+			{"target":{"uuid":"vm-2"},"newEntity":{"displayName":"GP3","className":"StorageTier"}}]`,
+		"GET /markets/3001/entities": `[
+			{"uuid":"vm-1","className":"VirtualMachine","costPrice":0.4,"template":{"displayName":"m5.xlarge"}},
+			{"uuid":"vm-2","className":"VirtualMachine","costPrice":0.2,"template":{"displayName":"t3.large"}}]`,
+		"POST /markets/3001/actions": `[]`,
+		"DELETE /groups/1000":        `{}`,
+	}
+	var requests []string
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/entities") {
+			assert.Equal(t, "virtualMachineAspect", r.URL.Query().Get("aspect_names"))
+		}
+		if r.Method+" "+r.URL.Path == "POST /scenarios" {
+			var scenario Scenario
+			assert.NoError(t, json.Unmarshal(body, &scenario))
+			assert.Equal(t, "CLOUD_MIGRATION", scenario.Type)
+			assert.Equal(t, []BaseApiDTO{{UUID: "1000"}, {UUID: "r-1"}, {UUID: "acct-1"}}, scenario.Scope)
+			assert.Equal(t, "1000", scenario.TopologyChanges.MigrateList[0].Source.UUID)
+			assert.Equal(t, "r-1", scenario.TopologyChanges.MigrateList[0].Destination.UUID)
+			assert.Equal(t, 5, len(scenario.ConfigChanges.OsMigrationSettingList))
+		}
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	report, err := client.RunCloudMigration(context.Background(), MigrationRequest{
+		DisplayName:            "Wave 1",
+		SourceEntityUuids:      []string{"vm-1", "vm-2"},
+		DestinationRegionUuid:  "r-1",
+		DestinationAccountUuid: "acct-1",
+		BringYourOwnLicense:    true,
+		PollInterval:           time.Millisecond,
+		Timeout:                time.Second,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "3000", report.PlanMarketUuid)
+	assert.Equal(t, "3001", report.LiftAndShiftMarketUuid)
+	assert.Equal(t, 2, len(report.VirtualMachines))
+
+	db := report.VirtualMachines[0]
+	assert.Equal(t, "db-01", db.DisplayName)
+	assert.Equal(t, "m5.large", db.ComputeTier)
+	assert.Equal(t, []string{"GP3", "IO2"}, db.StorageTiers)
+	assert.InDelta(t, 146.0, db.OptimizedMonthlyCost, 0.001)
+	assert.InDelta(t, 292.0, db.LiftAndShiftMonthlyCost, 0.001)
+	assert.Equal(t, "WINDOWS_BYOL", db.OS)
+	assert.Equal(t, BringYourOwnLicense, db.LicenseModel)
+	assert.Equal(t, LicenseIncluded, report.VirtualMachines[1].LicenseModel)
+
+	assert.InDelta(t, 219.0, report.OptimizedMonthlyCost, 0.001)
+	assert.InDelta(t, 438.0, report.LiftAndShiftMonthlyCost, 0.001)
+
+	// The group created for the source entities is removed with the report
+	assert.Empty(t, report.SourceGroupUuid)
+	assert.Equal(t, "DELETE /groups/1000", requests[len(requests)-1])
+}
+
+func TestRunCloudMigrationFailed(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	responses := map[string]string{
+		"POST /groups":                        `{"uuid":"1000","displayName":"Wave 1 - source"}`,
+		"POST /scenarios":                     `{"uuid":"2000","displayName":"Wave 1","type":"CLOUD_MIGRATION"}`,
+		"POST /markets/Market/scenarios/2000": `{"uuid":"3000","state":"RUNNING"}`,
+		"GET /markets/3000":                   `{"uuid":"3000","state":"FAILED"}`,
+		"DELETE /groups/1000":                 `{}`,
+	}
+	var requests []string
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(responses[r.Method+" "+r.URL.Path])); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	_, err := client.RunCloudMigration(context.Background(), MigrationRequest{
+		DisplayName:           "Wave 1",
+		SourceEntityUuids:     []string{"vm-1", "vm-2"},
+		DestinationRegionUuid: "r-1",
+		PollInterval:          time.Millisecond,
+		Timeout:               time.Second,
+	})
+
+	var migrationErr *MigrationError
+	if assert.True(t, errors.As(err, &migrationErr)) {
+		assert.Equal(t, "2000", migrationErr.ScenarioUuid)
+		assert.Equal(t, "3000", migrationErr.PlanMarketUuid)
+	}
+	assert.Contains(t, requests, "DELETE /groups/1000")
+}
+
+func TestRunCloudMigrationRequiresDestination(t *testing.T) {
+	client := &Client{}
+
+	_, err := client.RunCloudMigration(context.Background(), MigrationRequest{SourceGroupUuid: "1000"})
+	assert.Error(t, err)
+}
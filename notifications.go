@@ -0,0 +1,179 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// Path of the notification stream pushed to the UI
+const notificationsPath = "/ws/messages"
+
+// Delays between reconnection attempts to the notification stream
+var (
+	notificationMinBackoff = time.Second
+	notificationMaxBackoff = time.Minute
+)
+
+// Event pushed by Turbonomic on its notification stream. Only one of Action,
+// Market or Target is set, and Raw always holds the message as received.
+// Text messages are decoded as JSON. Binary messages, which may carry
+// protobuf encoded notifications, are not decoded: Binary is set and the
+// payload is only available in Raw.
+type Notification struct {
+	BroadcastId string              `json:"broadcastId,omitempty"`
+	Action      *ActionNotification `json:"actionNotification,omitempty"`
+	Market      *MarketNotification `json:"marketNotification,omitempty"`
+	Target      *TargetNotification `json:"targetNotification,omitempty"`
+	Binary      bool                `json:"-"`
+	Raw         []byte              `json:"-"`
+}
+
+// Progress or status change of actions
+type ActionNotification struct {
+	ActionProgress *ActionProgress `json:"actionProgressNotification,omitempty"`
+	ActionStatus   *ActionStatus   `json:"actionStatusNotification,omitempty"`
+	ActionsChanged *struct{}       `json:"actionsChangedNotification,omitempty"`
+}
+
+// Execution progress of an action
+type ActionProgress struct {
+	ActionId           string `json:"actionId"`
+	ProgressPercentage int    `json:"progressPercentage"`
+	Description        string `json:"description,omitempty"`
+}
+
+// Status of an action, such as SUCCEEDED or FAILED once executed
+type ActionStatus struct {
+	ActionId    string `json:"actionId"`
+	Status      string `json:"status"`
+	Description string `json:"description,omitempty"`
+}
+
+// Status change of a plan or of the real-time market
+type MarketNotification struct {
+	MarketId           string              `json:"marketId"`
+	StatusNotification *StatusNotification `json:"statusNotification,omitempty"`
+	StatusProgress     *StatusNotification `json:"statusProgressNotification,omitempty"`
+}
+
+// Validation or discovery status change of a target
+type TargetNotification struct {
+	TargetId           string              `json:"targetId"`
+	StatusNotification *StatusNotification `json:"statusNotification,omitempty"`
+}
+
+// Status reported by a notification
+type StatusNotification struct {
+	Status      string `json:"status"`
+	Description string `json:"description,omitempty"`
+	Progress    int    `json:"progressPercentage,omitempty"`
+}
+
+// Connects to the notification stream with the client's session and delivers
+// the notifications on the returned channel, reconnecting with backoff when
+// the connection is lost. The channel is closed once the context is done.
+// An error is returned when the first connection fails.
+func (c *Client) Subscribe(ctx context.Context) (<-chan Notification, error) {
+
+	streamUrl, err := c.notificationsURL()
+	if err != nil {
+		return nil, err
+	}
+	httpClient := websocketHTTPClient(c.HTTPClient)
+
+	conn, err := dialWebsocket(ctx, httpClient, streamUrl, c.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make(chan Notification, 64)
+	go func() {
+		defer close(notifications)
+
+		backoff := notificationMinBackoff
+		for {
+			if err := c.readNotifications(ctx, conn, notifications); err != nil && ctx.Err() == nil {
+				c.Logger.Error(c.Ctx, "notification stream disconnected: "+err.Error())
+			}
+
+			for conn = nil; conn == nil; {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(backoff*2, notificationMaxBackoff)
+
+				if conn, err = dialWebsocket(ctx, httpClient, streamUrl, c.Headers); err != nil && ctx.Err() == nil {
+					c.Logger.Error(c.Ctx, "reconnecting to notification stream: "+err.Error())
+				}
+			}
+			backoff = notificationMinBackoff
+		}
+	}()
+
+	return notifications, nil
+}
+
+// Delivers the notifications received on the connection until it fails or
+// the context is done
+func (c *Client) readNotifications(ctx context.Context, conn *wsConn, notifications chan<- Notification) error {
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	defer conn.Close()
+
+	binaryLogged := false
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		notification := Notification{}
+		if messageType == wsBinary {
+			// The format of binary messages is not known, so they are passed
+			// on undecoded rather than dropped
+			notification.Binary = true
+			if !binaryLogged {
+				c.Logger.Error(c.Ctx, "notification stream sends binary messages, which are delivered undecoded in Raw")
+				binaryLogged = true
+			}
+		} else if err := json.Unmarshal(message, &notification); err != nil {
+			c.Logger.Debug(c.Ctx, "undecoded notification: "+err.Error())
+		}
+		notification.Raw = message
+
+		select {
+		case notifications <- notification:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Returns the url of the notification stream on the host of the API
+func (c *Client) notificationsURL() (string, error) {
+	baseUrl, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	return (&url.URL{Scheme: baseUrl.Scheme, Host: baseUrl.Host, Path: notificationsPath}).String(), nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// Upgrades the request to a websocket connection, returning the raw connection
+func acceptWebsocket(t *testing.T, w http.ResponseWriter, r *http.Request) (net.Conn, *bufio.ReadWriter) {
+	assert.Equal(t, "websocket", r.Header.Get("Upgrade"))
+	assert.Equal(t, "13", r.Header.Get("Sec-WebSocket-Version"))
+
+	conn, rw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + wsAcceptKey(r.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+	assert.NoError(t, err)
+	assert.NoError(t, rw.Flush())
+	return conn, rw
+}
+
+// Writes an unmasked frame, as sent by servers
+func writeServerFrame(t *testing.T, rw *bufio.ReadWriter, opcode byte, payload string) {
+	frame := append([]byte{0x80 | opcode, byte(len(payload))}, payload...)
+	_, err := rw.Write(frame)
+	assert.NoError(t, err)
+	assert.NoError(t, rw.Flush())
+}
+
+func newNotificationsClient(t *testing.T, serverUrl string) *Client {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	jar, _ := cookiejar.New(nil)
+	u, _ := url.Parse(serverUrl)
+	jar.SetCookies(u, []*http.Cookie{{Name: "JSESSIONID", Value: "session"}})
+
+	return &Client{
+		BaseURL: serverUrl + "/api/v3",
+		HTTPClient: &http.Client{
+			Jar:       jar,
+			Transport: customTransport,
+			Timeout:   time.Second,
+		},
+		Headers: map[string]string{"X-Test": "header"},
+		Logger:  logging.NewSlogLogger(),
+		Ctx:     context.Background(),
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	minBackoff, maxBackoff := notificationMinBackoff, notificationMaxBackoff
+	notificationMinBackoff, notificationMaxBackoff = 10*time.Millisecond, 20*time.Millisecond
+	defer func() { notificationMinBackoff, notificationMaxBackoff = minBackoff, maxBackoff }()
+
+	var connections atomic.Int32
+	done := make(chan struct{})
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ws/messages", r.URL.Path)
+		assert.Equal(t, "header", r.Header.Get("X-Test"))
+		cookie, err := r.Cookie("JSESSIONID")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "session", cookie.Value)
+		}
+
+		conn, rw := acceptWebsocket(t, w, r)
+		defer conn.Close()
+
+		if connections.Add(1) == 1 {
+			writeServerFrame(t, rw, wsText, `{"broadcastId":"1","actionNotification":{"actionStatusNotification":{"actionId":"123","status":"SUCCEEDED"}}}`)
+
+			// The client answers pings with the same payload
+			writeServerFrame(t, rw, wsPing, "ping")
+			fin, opcode, payload, err := (&wsConn{reader: rw.Reader}).readFrame()
+			assert.NoError(t, err)
+			assert.True(t, fin)
+			assert.Equal(t, byte(wsPong), opcode)
+			assert.Equal(t, "ping", string(payload))
+
+			writeServerFrame(t, rw, wsText, `not a notification`)
+			writeServerFrame(t, rw, wsBinary, "\x0a\x011\x12\x00")
+			// Dropping the connection makes the client reconnect
+			return
+		}
+
+		writeServerFrame(t, rw, wsText, `{"targetNotification":{"targetId":"456","statusNotification":{"status":"VALIDATED"}}}`)
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	client := newNotificationsClient(t, ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	notifications, err := client.Subscribe(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	receive := func() Notification {
+		select {
+		case notification := <-notifications:
+			return notification
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a notification")
+			return Notification{}
+		}
+	}
+
+	notification := receive()
+	assert.Equal(t, "1", notification.BroadcastId)
+	if assert.NotNil(t, notification.Action) && assert.NotNil(t, notification.Action.ActionStatus) {
+		assert.Equal(t, "123", notification.Action.ActionStatus.ActionId)
+		assert.Equal(t, "SUCCEEDED", notification.Action.ActionStatus.Status)
+	}
+
+	notification = receive()
+	assert.Nil(t, notification.Action)
+	assert.Equal(t, "not a notification", string(notification.Raw))
+	assert.False(t, notification.Binary)
+
+	// Binary messages are delivered undecoded
+	notification = receive()
+	assert.True(t, notification.Binary)
+	assert.Nil(t, notification.Action)
+	assert.Equal(t, []byte{0x0a, 0x01, '1', 0x12, 0x00}, notification.Raw)
+
+	notification = receive()
+	if assert.NotNil(t, notification.Target) {
+		assert.Equal(t, "456", notification.Target.TargetId)
+		assert.Equal(t, "VALIDATED", notification.Target.StatusNotification.Status)
+	}
+	assert.Equal(t, int32(2), connections.Load())
+
+	cancel()
+	select {
+	case _, ok := <-notifications:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed after the context was cancelled")
+	}
+}
+
+func TestSubscribeUnauthorized(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	client := newNotificationsClient(t, ts.URL)
+	notifications, err := client.Subscribe(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, notifications)
+}
@@ -0,0 +1,322 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Uuid of the real-time market, from which plans are run by default
+const RealtimeMarket = "Market"
+
+// States of a plan market
+const (
+	PlanSucceeded = "SUCCEEDED"
+	PlanFailed    = "FAILED"
+	PlanStopped   = "STOPPED"
+)
+
+// Defaults used when waiting for a plan to complete
+const (
+	DefaultPlanPollInterval = 15 * time.Second
+	DefaultPlanTimeout      = time.Hour
+)
+
+// Parameters for retriving or deleting a scenario from Turbonomic's API
+type ScenarioRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating a scenario using Turbonomic's API
+type ScenarioInputRequest struct {
+	Scenario        Scenario
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for running a scenario as a plan
+type RunScenarioRequest struct {
+	ScenarioUuid    string
+	MarketUuid      string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving or deleting a plan market from Turbonomic's API
+type MarketRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for waiting until a plan completes
+type WaitForPlanRequest struct {
+	Uuid            string
+	PollInterval    time.Duration
+	Timeout         time.Duration
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving the actions of a plan market
+type PlanActionsRequest struct {
+	MarketUuid      string
+	ActionState     []string
+	ActionType      []string
+	DetailLevel     string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving the statistics of a plan market
+type PlanStatsRequest struct {
+	MarketUuid      string
+	StartDate       string
+	EndDate         string
+	Statistics      []StatisticRequest
+	CommonReqParams CommonReqParams
+}
+
+// What-if scenario evaluated by a plan
+type Scenario struct {
+	UUID            string           `json:"uuid,omitempty"`
+	DisplayName     string           `json:"displayName"`
+	Type            string           `json:"type,omitempty"`
+	Scope           []BaseApiDTO     `json:"scope,omitempty"`
+	ProjectionDays  []int            `json:"projectionDays,omitempty"`
+	TopologyChanges *TopologyChanges `json:"topologyChanges,omitempty"`
+	ConfigChanges   *ConfigChanges   `json:"configChanges,omitempty"`
+}
+
+// Workloads added, removed, replaced or migrated by a scenario
+type TopologyChanges struct {
+	AddList     []AddObject     `json:"addList,omitempty"`
+	RemoveList  []RemoveObject  `json:"removeList,omitempty"`
+	ReplaceList []ReplaceObject `json:"replaceList,omitempty"`
+	MigrateList []MigrateObject `json:"migrateList,omitempty"`
+}
+
+// Copies of an entity, group or template added by a scenario
+type AddObject struct {
+	Target         BaseApiDTO `json:"target"`
+	Count          int        `json:"count"`
+	ProjectionDays []int      `json:"projectionDays,omitempty"`
+}
+
+// Entity or group removed by a scenario
+type RemoveObject struct {
+	Target        BaseApiDTO `json:"target"`
+	ProjectionDay int        `json:"projectionDay"`
+}
+
+// Entity or group replaced with a template by a scenario
+type ReplaceObject struct {
+	Target        BaseApiDTO `json:"target"`
+	Template      BaseApiDTO `json:"template"`
+	ProjectionDay int        `json:"projectionDay"`
+}
+
+// Workloads migrated from a source to a destination by a scenario
+type MigrateObject struct {
+	Source                      BaseApiDTO `json:"source"`
+	Destination                 BaseApiDTO `json:"destination"`
+	DestinationEntityType       string     `json:"destinationEntityType,omitempty"`
+	RemoveNonMigratingWorkloads bool       `json:"removeNonMigratingWorkloads,omitempty"`
+	ProjectionDay               int        `json:"projectionDay"`
+}
+
+// Policy and setting changes applied by a scenario
+type ConfigChanges struct {
+	AddPolicyList          []PlacementPolicy  `json:"addPolicyList,omitempty"`
+	RemovePolicyList       []PlacementPolicy  `json:"removePolicyList,omitempty"`
+	RemoveConstraintList   []RemoveConstraint `json:"removeConstraintList,omitempty"`
+	AutomationSettingList  []Setting          `json:"automationSettingList,omitempty"`
+	OsMigrationSettingList []Setting          `json:"osMigrationSettingList,omitempty"`
+}
+
+// Placement constraint ignored by a scenario
+type RemoveConstraint struct {
+	Target         *BaseApiDTO `json:"target,omitempty"`
+	ConstraintType string      `json:"constraintType"`
+	ProjectionDay  int         `json:"projectionDay"`
+}
+
+// Market in which a plan, or the real-time analysis, runs
+type Market struct {
+	UUID               string    `json:"uuid"`
+	DisplayName        string    `json:"displayName"`
+	ClassName          string    `json:"className"`
+	State              string    `json:"state"`
+	StateProgress      int       `json:"stateProgress"`
+	Scenario           *Scenario `json:"scenario,omitempty"`
+	RunDate            string    `json:"runDate,omitempty"`
+	RunCompleteDate    string    `json:"runCompleteDate,omitempty"`
+	Saved              bool      `json:"saved"`
+	RelatedPlanMarkets []Market  `json:"relatedPlanMarkets,omitempty"`
+}
+
+// Reports whether the plan is no longer running
+func (m Market) Done() bool {
+	return m.State == PlanSucceeded || m.State == PlanFailed || m.State == PlanStopped
+}
+
+// Creates a scenario which can then be run as a plan
+func (c *Client) CreateScenario(scenarioReq ScenarioInputRequest) (*Scenario, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(scenarioReq.Scenario); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "POST", Path: "/scenarios", ReqDTO: dtoBuf,
+		CommonReqParams: scenarioReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var scenario Scenario
+	if err := json.Unmarshal(restResp, &scenario); err != nil {
+		return nil, err
+	}
+
+	return &scenario, nil
+}
+
+// Deletes the scenario with the provided uuid
+func (c *Client) DeleteScenario(scenarioReq ScenarioRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/scenarios/" + scenarioReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: scenarioReq.CommonReqParams})
+
+	return err
+}
+
+// Runs a scenario against the provided market, the real-time market by
+// default, returning the plan market that was created
+func (c *Client) RunScenario(runReq RunScenarioRequest) (*Market, error) {
+
+	marketUuid := runReq.MarketUuid
+	if marketUuid == "" {
+		marketUuid = RealtimeMarket
+	}
+
+	return c.sendMarket(context.Background(), "POST", "/markets/"+marketUuid+"/scenarios/"+runReq.ScenarioUuid, runReq.CommonReqParams)
+}
+
+// Retrives a market based on its provided uuid
+func (c *Client) GetMarket(marketReq MarketRequest) (*Market, error) {
+	return c.sendMarket(context.Background(), "GET", "/markets/"+marketReq.Uuid, marketReq.CommonReqParams)
+}
+
+// Polls the plan market with the provided uuid until it stops running,
+// returning an error if the plan did not succeed or the context is done
+func (c *Client) WaitForPlan(ctx context.Context, waitReq WaitForPlanRequest) (*Market, error) {
+
+	interval := waitReq.PollInterval
+	if interval <= 0 {
+		interval = DefaultPlanPollInterval
+	}
+	timeout := waitReq.Timeout
+	if timeout <= 0 {
+		timeout = DefaultPlanTimeout
+	}
+
+	var market *Market
+	err := poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		market, err = c.sendMarket(ctx, "GET", "/markets/"+waitReq.Uuid, waitReq.CommonReqParams)
+		if err != nil {
+			return false, err
+		}
+		return market.Done(), nil
+	})
+	if err != nil {
+		return market, fmt.Errorf("waiting for plan %s: %w", waitReq.Uuid, err)
+	}
+	if market.State != PlanSucceeded {
+		return market, fmt.Errorf("plan %s did not succeed: %s", waitReq.Uuid, market.State)
+	}
+
+	return market, nil
+}
+
+// Retrives the projected entities of a plan market
+func (c *Client) GetMarketEntities(marketReq MarketRequest) ([]EntityResults, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/markets/" + marketReq.Uuid + "/entities",
+		ReqDTO: new(bytes.Buffer), CommonReqParams: marketReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []EntityResults
+	if err := json.Unmarshal(restResp, &entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// Retrives the actions recommended by a plan
+func (c *Client) GetPlanActions(actionsReq PlanActionsRequest) (ActionResults, error) {
+
+	actionCriteria := ActionsCriteria{
+		ActionStateList: actionsReq.ActionState,
+		ActionTypeList:  actionsReq.ActionType,
+		DetailLevel:     actionsReq.DetailLevel,
+	}
+
+	return c.postActions("/markets/"+actionsReq.MarketUuid+"/actions", actionCriteria, actionsReq.CommonReqParams)
+}
+
+// Retrives statistics of a plan, including the projected values after its actions
+func (c *Client) GetPlanStats(statsReq PlanStatsRequest) (StatsResponse, error) {
+
+	requestBody := StatsRequestBody{
+		StartDate:  statsReq.StartDate,
+		EndDate:    statsReq.EndDate,
+		Statistics: statsReq.Statistics,
+	}
+
+	return c.postStats("/markets/"+statsReq.MarketUuid+"/stats", requestBody, statsReq.CommonReqParams)
+}
+
+// Deletes the plan market with the provided uuid
+func (c *Client) DeletePlan(marketReq MarketRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/markets/" + marketReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: marketReq.CommonReqParams})
+
+	return err
+}
+
+func (c *Client) sendMarket(ctx context.Context, method, urlPath string, reqParams CommonReqParams) (*Market, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: method, Path: urlPath, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var market Market
+	if err := json.Unmarshal(restResp, &market); err != nil {
+		return nil, err
+	}
+
+	return &market, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunPlan(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock responses from the Turbonomic API
+	actionsResponse, err := os.ReadFile("./testfiles/GetActionsByUuid.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+	statsResponse, err := os.ReadFile("./testfiles/GetStats.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	marketPolls := 0
+	var requests []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+
+		var response string
+		switch r.Method + " " + r.URL.Path {
+		case "POST /scenarios":
+			assert.Equal(t, "{\"displayName\":\"Add 200 VMs\",\"type\":\"ADD_WORKLOAD\","+
+				"\"scope\":[{\"uuid\":\"285024588762240\"}],"+
+				"\"topologyChanges\":{\"addList\":[{\"target\":{\"uuid\":\"75941320319680\"},\"count\":200}]}}\n",
+				string(body))
+			response = `{"uuid":"8888","displayName":"Add 200 VMs","type":"ADD_WORKLOAD"}`
+		case "POST /markets/Market/scenarios/8888":
+			response = `{"uuid":"9999","displayName":"Add 200 VMs","state":"READY_TO_START"}`
+		case "GET /markets/9999":
+			marketPolls++
+			if marketPolls < 2 {
+				response = `{"uuid":"9999","state":"RUNNING","stateProgress":40}`
+			} else {
+				response = `{"uuid":"9999","state":"SUCCEEDED","stateProgress":100}`
+			}
+		case "POST /markets/9999/actions":
+			assert.Equal(t, "{\"actionStateList\":null,\"actionTypeList\":[\"PROVISION\"]}\n", string(body))
+			response = string(actionsResponse)
+		case "POST /markets/9999/stats":
+			assert.Contains(t, string(body), "\"endDate\":\"+1d\"")
+			response = string(statsResponse)
+		case "DELETE /markets/9999":
+			response = "true"
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	scenario, err := client.CreateScenario(ScenarioInputRequest{Scenario: Scenario{
+		DisplayName: "Add 200 VMs",
+		Type:        "ADD_WORKLOAD",
+		Scope:       []BaseApiDTO{{UUID: "285024588762240"}},
+		TopologyChanges: &TopologyChanges{
+			AddList: []AddObject{{Target: BaseApiDTO{UUID: "75941320319680"}, Count: 200}},
+		},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "8888", scenario.UUID)
+
+	market, err := client.RunScenario(RunScenarioRequest{ScenarioUuid: scenario.UUID})
+	assert.NoError(t, err)
+
+	market, err = client.WaitForPlan(context.Background(), WaitForPlanRequest{Uuid: market.UUID, PollInterval: time.Millisecond, Timeout: time.Second})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, market.StateProgress)
+
+	actions, err := client.GetPlanActions(PlanActionsRequest{MarketUuid: market.UUID, ActionType: []string{"PROVISION"}})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, actions)
+
+	stats, err := client.GetPlanStats(PlanStatsRequest{MarketUuid: market.UUID, EndDate: "+1d",
+		Statistics: []StatisticRequest{{Name: "numVMs"}}})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, stats)
+
+	assert.NoError(t, client.DeletePlan(MarketRequest{Uuid: market.UUID}))
+	assert.Equal(t, 7, len(requests))
+}
+
+func TestWaitForPlanFailed(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/markets/9999", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"9999","state":"FAILED"}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	market, err := client.WaitForPlan(context.Background(), WaitForPlanRequest{Uuid: "9999", PollInterval: time.Millisecond, Timeout: time.Second})
+
+	assert.Error(t, err)
+	assert.Equal(t, PlanFailed, market.State)
+}
+
+func TestWaitForPlanCancelled(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The plan keeps running, and the wait is cancelled after the first poll
+		cancel()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"9999","state":"RUNNING"}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	_, err := client.WaitForPlan(ctx, WaitForPlanRequest{Uuid: "9999", PollInterval: time.Minute, Timeout: time.Hour})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Type of a Turbonomic placement policy
+type PlacementPolicyType string
+
+const (
+	MERGE                       PlacementPolicyType = "MERGE"
+	BIND_TO_GROUP               PlacementPolicyType = "BIND_TO_GROUP"
+	BIND_TO_COMPLEMENTARY_GROUP PlacementPolicyType = "BIND_TO_COMPLEMENTARY_GROUP"
+	BIND_TO_GROUP_AND_LICENSE   PlacementPolicyType = "BIND_TO_GROUP_AND_LICENSE"
+	AT_MOST_N                   PlacementPolicyType = "AT_MOST_N"
+	AT_MOST_N_BOUND             PlacementPolicyType = "AT_MOST_N_BOUND"
+	MUST_RUN_TOGETHER           PlacementPolicyType = "MUST_RUN_TOGETHER"
+	MUST_NOT_RUN_TOGETHER       PlacementPolicyType = "MUST_NOT_RUN_TOGETHER"
+)
+
+// Kind of entities merged by a MERGE placement policy
+type MergePolicyType string
+
+const (
+	MERGE_CLUSTER         MergePolicyType = "Cluster"
+	MERGE_STORAGE_CLUSTER MergePolicyType = "StorageCluster"
+	MERGE_DATACENTER      MergePolicyType = "DataCenter"
+	MERGE_DESKTOP_POOL    MergePolicyType = "DesktopPool"
+)
+
+// Parameters for retriving a placement policy from Turbonomic's API
+type PlacementPolicyRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a placement policy using Turbonomic's API
+type PlacementPolicyInputRequest struct {
+	Uuid            string
+	Policy          PlacementPolicyInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API placement policies
+type PlacementPolicyInput struct {
+	PolicyName    string              `json:"policyName"`
+	Type          PlacementPolicyType `json:"type"`
+	ConsumerGroup string              `json:"buyerUuid,omitempty"`
+	ProviderGroup string              `json:"sellerUuid,omitempty"`
+	MergeGroups   []string            `json:"mergeUuids,omitempty"`
+	MergeType     MergePolicyType     `json:"mergeType,omitempty"`
+	Capacity      *int                `json:"capacity,omitempty"`
+	Enabled       bool                `json:"enabled"`
+}
+
+// Placement policy returned by Turbonomic's API
+type PlacementPolicy struct {
+	UUID          string              `json:"uuid"`
+	DisplayName   string              `json:"displayName"`
+	Name          string              `json:"name"`
+	Type          PlacementPolicyType `json:"type"`
+	Enabled       bool                `json:"enabled"`
+	Capacity      *int                `json:"capacity,omitempty"`
+	MergeType     MergePolicyType     `json:"mergeType,omitempty"`
+	ConsumerGroup *Group              `json:"consumerGroup,omitempty"`
+	ProviderGroup *Group              `json:"providerGroup,omitempty"`
+	MergeGroups   []Group             `json:"mergeGroups,omitempty"`
+	CommodityType string              `json:"commodityType,omitempty"`
+}
+
+// Builds the input required to update the placement policy with its current values
+func (p PlacementPolicy) Input() PlacementPolicyInput {
+	input := PlacementPolicyInput{
+		PolicyName: p.DisplayName,
+		Type:       p.Type,
+		MergeType:  p.MergeType,
+		Capacity:   p.Capacity,
+		Enabled:    p.Enabled,
+	}
+	if input.PolicyName == "" {
+		input.PolicyName = p.Name
+	}
+	if p.ConsumerGroup != nil {
+		input.ConsumerGroup = p.ConsumerGroup.UUID
+	}
+	if p.ProviderGroup != nil {
+		input.ProviderGroup = p.ProviderGroup.UUID
+	}
+	for _, group := range p.MergeGroups {
+		input.MergeGroups = append(input.MergeGroups, group.UUID)
+	}
+	return input
+}
+
+// Retrives all placement policies
+func (c *Client) GetPlacementPolicies(reqParams CommonReqParams) ([]PlacementPolicy, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/policies", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []PlacementPolicy
+	if err := json.Unmarshal(restResp, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Retrives a placement policy based on its provided uuid
+func (c *Client) GetPlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/policies/" + policyReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: policyReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var policy PlacementPolicy
+	if err := json.Unmarshal(restResp, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Creates a placement policy
+func (c *Client) CreatePlacementPolicy(policyReq PlacementPolicyInputRequest) (*PlacementPolicy, error) {
+	return c.sendPlacementPolicy("POST", "/policies", policyReq)
+}
+
+// Updates the placement policy with the provided uuid
+func (c *Client) UpdatePlacementPolicy(policyReq PlacementPolicyInputRequest) (*PlacementPolicy, error) {
+	return c.sendPlacementPolicy("PUT", "/policies/"+policyReq.Uuid, policyReq)
+}
+
+// Enables the placement policy with the provided uuid
+func (c *Client) EnablePlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error) {
+	return c.setPlacementPolicyEnabled(policyReq, true)
+}
+
+// Disables the placement policy with the provided uuid
+func (c *Client) DisablePlacementPolicy(policyReq PlacementPolicyRequest) (*PlacementPolicy, error) {
+	return c.setPlacementPolicyEnabled(policyReq, false)
+}
+
+// Deletes the placement policy with the provided uuid
+func (c *Client) DeletePlacementPolicy(policyReq PlacementPolicyRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/policies/" + policyReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: policyReq.CommonReqParams})
+
+	return err
+}
+
+// The API only accepts complete policy definitions on update, so the current
+// policy is read back before toggling its enabled flag
+func (c *Client) setPlacementPolicyEnabled(policyReq PlacementPolicyRequest, enabled bool) (*PlacementPolicy, error) {
+
+	policy, err := c.GetPlacementPolicy(policyReq)
+	if err != nil {
+		return nil, err
+	}
+
+	input := policy.Input()
+	input.Enabled = enabled
+
+	return c.UpdatePlacementPolicy(PlacementPolicyInputRequest{
+		Uuid:            policyReq.Uuid,
+		Policy:          input,
+		CommonReqParams: policyReq.CommonReqParams,
+	})
+}
+
+func (c *Client) sendPlacementPolicy(method, urlPath string, policyReq PlacementPolicyInputRequest) (*PlacementPolicy, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(policyReq.Policy); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: policyReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var policy PlacementPolicy
+	if err := json.Unmarshal(restResp, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
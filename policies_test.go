@@ -0,0 +1,173 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPlacementPolicy(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetPlacementPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	// Create a test server with the mock response
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/policies/637195405832576", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Errorf("failed to write header: %s", err.Error())
+			t.FailNow()
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	policy, err := client.GetPlacementPolicy(PlacementPolicyRequest{Uuid: "637195405832576"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, BIND_TO_GROUP, policy.Type)
+	assert.True(t, policy.Enabled)
+	assert.Equal(t, 10, *policy.Capacity)
+	assert.Equal(t, "285024588762240", policy.ConsumerGroup.UUID)
+	assert.Equal(t, "PhysicalMachine", policy.ProviderGroup.GroupType)
+}
+
+func TestCreatePlacementPolicy(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	mockResponse, err := os.ReadFile("./testfiles/GetPlacementPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/policies", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"policyName\":\"AppInfra VMs on AppInfra Hosts\",\"type\":\"BIND_TO_GROUP\","+
+			"\"buyerUuid\":\"285024588762240\",\"sellerUuid\":\"285024588762999\",\"capacity\":10,\"enabled\":true}\n",
+			string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	capacity := 10
+	policy, err := client.CreatePlacementPolicy(PlacementPolicyInputRequest{
+		Policy: PlacementPolicyInput{
+			PolicyName:    "AppInfra VMs on AppInfra Hosts",
+			Type:          BIND_TO_GROUP,
+			ConsumerGroup: "285024588762240",
+			ProviderGroup: "285024588762999",
+			Capacity:      &capacity,
+			Enabled:       true,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "637195405832576", policy.UUID)
+}
+
+func TestDisablePlacementPolicy(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	mockResponse, err := os.ReadFile("./testfiles/GetPlacementPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	var methods []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/policies/637195405832576", r.URL.Path)
+		methods = append(methods, r.Method)
+		if r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, "{\"policyName\":\"AppInfra VMs on AppInfra Hosts\",\"type\":\"BIND_TO_GROUP\","+
+				"\"buyerUuid\":\"285024588762240\",\"sellerUuid\":\"285024588762999\",\"capacity\":10,\"enabled\":false}\n",
+				string(body))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	_, err = client.DisablePlacementPolicy(PlacementPolicyRequest{Uuid: "637195405832576"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET", "PUT"}, methods)
+}
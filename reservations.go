@@ -0,0 +1,252 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Status of a reservation
+const (
+	ReservationInitial    = "INITIAL"
+	ReservationInProgress = "INPROGRESS"
+	// Reserved at a future date, when its placement is decided
+	ReservationFuture          = "FUTURE"
+	ReservationReserved        = "RESERVED"
+	ReservationPlacementFailed = "PLACEMENT_FAILED"
+	ReservationUnfulfilled     = "UNFULFILLED"
+	ReservationInvalid         = "INVALID"
+)
+
+// Defaults used when waiting for a reservation to be placed
+const (
+	DefaultReservationPollInterval = 5 * time.Second
+	DefaultReservationTimeout      = 10 * time.Minute
+)
+
+// Parameters for retriving or deleting a reservation from Turbonomic's API
+type ReservationRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating a reservation using Turbonomic's API
+type ReservationInputRequest struct {
+	Reservation     ReservationInput
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for waiting until the placement of a reservation is decided
+type WaitForReservationRequest struct {
+	Uuid            string
+	PollInterval    time.Duration
+	Timeout         time.Duration
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST requests of Turbonomic API reservations
+type ReservationInput struct {
+	DisplayName     string                 `json:"displayName"`
+	DemandType      string                 `json:"demandType"`
+	ReserveDateTime string                 `json:"reserveDateTime,omitempty"`
+	ExpireDateTime  string                 `json:"expireDateTime,omitempty"`
+	Parameters      []ReservationParameter `json:"parameters"`
+}
+
+// Workload requested by a reservation
+type ReservationParameter struct {
+	PlacementParameters PlacementParameters `json:"placementParameters"`
+}
+
+// Template, count and placement constraints of a reserved workload
+type PlacementParameters struct {
+	Count         int      `json:"count"`
+	TemplateID    string   `json:"templateID"`
+	ConstraintIDs []string `json:"constraintIDs,omitempty"`
+}
+
+// Reservation returned by Turbonomic's API
+type Reservation struct {
+	UUID            string              `json:"uuid"`
+	DisplayName     string              `json:"displayName"`
+	Count           int                 `json:"count"`
+	Status          string              `json:"status"`
+	ReserveDateTime string              `json:"reserveDateTime,omitempty"`
+	ExpireDateTime  string              `json:"expireDateTime,omitempty"`
+	Template        *BaseApiDTO         `json:"template,omitempty"`
+	DemandEntities  []ReservationEntity `json:"demandEntities"`
+}
+
+// Single reserved instance and its placement
+type ReservationEntity struct {
+	UUID        string                `json:"uuid"`
+	DisplayName string                `json:"displayName"`
+	Template    *BaseApiDTO           `json:"template,omitempty"`
+	Placements  ReservationPlacements `json:"placements"`
+}
+
+// Providers chosen for a reserved instance
+type ReservationPlacements struct {
+	ComputeResources []PlacementResource `json:"computeResources"`
+	StorageResources []PlacementResource `json:"storageResources"`
+	NetworkResources []PlacementResource `json:"networkResources,omitempty"`
+}
+
+// Provider chosen for a reserved instance and the resources it reserves
+type PlacementResource struct {
+	Provider *BaseApiDTO `json:"provider,omitempty"`
+	Stats    []Statistic `json:"stats,omitempty"`
+}
+
+// Builds the input for a reservation of count instances of a template
+func NewReservation(displayName, templateUuid string, count int, constraintUuids ...string) ReservationInput {
+	return ReservationInput{
+		DisplayName: displayName,
+		DemandType:  "RESERVATION",
+		Parameters: []ReservationParameter{{PlacementParameters: PlacementParameters{
+			Count:         count,
+			TemplateID:    templateUuid,
+			ConstraintIDs: constraintUuids,
+		}}},
+	}
+}
+
+// Reports whether Turbonomic is still deciding the placement of the reservation
+func (r Reservation) Pending() bool {
+	return r.Status == ReservationInitial || r.Status == ReservationInProgress
+}
+
+// Reports whether the reservation waits for its reserve date to be placed
+func (r Reservation) Scheduled() bool {
+	return r.Status == ReservationFuture
+}
+
+// Returns the hosts chosen for the reserved instance
+func (e ReservationEntity) Hosts() []BaseApiDTO {
+	return placementProviders(e.Placements.ComputeResources)
+}
+
+// Returns the storage chosen for the reserved instance
+func (e ReservationEntity) Storage() []BaseApiDTO {
+	return placementProviders(e.Placements.StorageResources)
+}
+
+// Retrives all reservations
+func (c *Client) GetReservations(reqParams CommonReqParams) ([]Reservation, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/reservations", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var reservations []Reservation
+	if err := json.Unmarshal(restResp, &reservations); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// Retrives a reservation based on its provided uuid
+func (c *Client) GetReservation(reservationReq ReservationRequest) (*Reservation, error) {
+	return c.sendReservation(context.Background(), "GET", "/reservations/"+reservationReq.Uuid, new(bytes.Buffer), reservationReq.CommonReqParams)
+}
+
+// Creates a reservation. Its placement is decided asynchronously, see WaitForReservation.
+func (c *Client) CreateReservation(reservationReq ReservationInputRequest) (*Reservation, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(reservationReq.Reservation); err != nil {
+		return nil, err
+	}
+
+	return c.sendReservation(context.Background(), "POST", "/reservations", dtoBuf, reservationReq.CommonReqParams)
+}
+
+// Deletes the reservation with the provided uuid, releasing its capacity
+func (c *Client) DeleteReservation(reservationReq ReservationRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/reservations/" + reservationReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reservationReq.CommonReqParams})
+
+	return err
+}
+
+// Polls the reservation with the provided uuid until its placement is decided,
+// returning an error if the capacity could not be reserved or the context is
+// done. A reservation with a future reserve date is returned as soon as it is
+// scheduled, without error; see Scheduled.
+func (c *Client) WaitForReservation(ctx context.Context, waitReq WaitForReservationRequest) (*Reservation, error) {
+
+	interval := waitReq.PollInterval
+	if interval <= 0 {
+		interval = DefaultReservationPollInterval
+	}
+	timeout := waitReq.Timeout
+	if timeout <= 0 {
+		timeout = DefaultReservationTimeout
+	}
+
+	var reservation *Reservation
+	err := poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		reservation, err = c.sendReservation(ctx, "GET", "/reservations/"+waitReq.Uuid, new(bytes.Buffer), waitReq.CommonReqParams)
+		if err != nil {
+			return false, err
+		}
+		return !reservation.Pending(), nil
+	})
+	if err != nil {
+		return reservation, fmt.Errorf("waiting for reservation %s: %w", waitReq.Uuid, err)
+	}
+	if reservation.Status != ReservationReserved && !reservation.Scheduled() {
+		return reservation, fmt.Errorf("reservation %s was not placed: %s", waitReq.Uuid, reservation.Status)
+	}
+
+	return reservation, nil
+}
+
+func (c *Client) sendReservation(ctx context.Context, method, urlPath string, dtoBuf *bytes.Buffer, reqParams CommonReqParams) (*Reservation, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var reservation Reservation
+	if err := json.Unmarshal(restResp, &reservation); err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func placementProviders(resources []PlacementResource) []BaseApiDTO {
+	var providers []BaseApiDTO
+	for _, resource := range resources {
+		if resource.Provider != nil {
+			providers = append(providers, *resource.Provider)
+		}
+	}
+	return providers
+}
@@ -0,0 +1,174 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservationLifecycle(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetReservation.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	polls := 0
+	deleted := false
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var response string
+		switch r.Method + " " + r.URL.Path {
+		case "POST /reservations":
+			assert.Equal(t, "{\"displayName\":\"batch-42\",\"demandType\":\"RESERVATION\","+
+				"\"parameters\":[{\"placementParameters\":{\"count\":2,\"templateID\":\"75941320088888\","+
+				"\"constraintIDs\":[\"285024588762240\"]}}]}\n", string(body))
+			response = `{"uuid":"637195406543210","displayName":"batch-42","count":2,"status":"INITIAL"}`
+		case "GET /reservations/637195406543210":
+			polls++
+			if polls < 2 {
+				response = `{"uuid":"637195406543210","displayName":"batch-42","count":2,"status":"INPROGRESS"}`
+			} else {
+				response = string(mockResponse)
+			}
+		case "DELETE /reservations/637195406543210":
+			deleted = true
+			response = "true"
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	reservation, err := client.CreateReservation(ReservationInputRequest{
+		Reservation: NewReservation("batch-42", "75941320088888", 2, "285024588762240"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, reservation.Pending())
+
+	reservation, err = client.WaitForReservation(context.Background(), WaitForReservationRequest{Uuid: reservation.UUID,
+		PollInterval: time.Millisecond, Timeout: time.Second})
+	assert.NoError(t, err)
+	assert.Equal(t, ReservationReserved, reservation.Status)
+	assert.Equal(t, 2, len(reservation.DemandEntities))
+	assert.Equal(t, []BaseApiDTO{{UUID: "75941320011111", DisplayName: "esx-01.turbo.com", ClassName: "PhysicalMachine"}},
+		reservation.DemandEntities[0].Hosts())
+	assert.Equal(t, "esx-02.turbo.com", reservation.DemandEntities[1].Hosts()[0].DisplayName)
+	assert.Equal(t, "datastore-01", reservation.DemandEntities[1].Storage()[0].DisplayName)
+
+	assert.NoError(t, client.DeleteReservation(ReservationRequest{Uuid: reservation.UUID}))
+	assert.True(t, deleted)
+}
+
+func TestWaitForReservationFailed(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/reservations/1234", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"1234","status":"PLACEMENT_FAILED"}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	reservation, err := client.WaitForReservation(context.Background(), WaitForReservationRequest{Uuid: "1234",
+		PollInterval: time.Millisecond, Timeout: time.Second})
+
+	assert.Error(t, err)
+	assert.Equal(t, ReservationPlacementFailed, reservation.Status)
+}
+
+func TestWaitForReservationScheduled(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"1234","status":"FUTURE","reserveDateTime":"2026-12-01T00:00:00Z"}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	reservation, err := client.WaitForReservation(context.Background(), WaitForReservationRequest{Uuid: "1234",
+		PollInterval: time.Millisecond, Timeout: time.Second})
+
+	assert.NoError(t, err)
+	assert.True(t, reservation.Scheduled())
+	assert.False(t, reservation.Pending())
+}
@@ -0,0 +1,338 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Frequency of a recurring schedule
+type RecurrenceType string
+
+const (
+	DAILY   RecurrenceType = "DAILY"
+	WEEKLY  RecurrenceType = "WEEKLY"
+	MONTHLY RecurrenceType = "MONTHLY"
+)
+
+// Number of days searched ahead when computing the next occurrence of a schedule
+const scheduleSearchDays = 5 * 366
+
+var scheduleTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Parameters for retriving a schedule from Turbonomic's API
+type ScheduleRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a schedule using Turbonomic's API
+type ScheduleInputRequest struct {
+	Uuid            string
+	Schedule        ScheduleInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API schedules. Times are
+// expressed as local times, without offset, in the schedule's time zone.
+type ScheduleInput struct {
+	DisplayName string      `json:"displayName"`
+	StartTime   string      `json:"startTime"`
+	EndTime     string      `json:"endTime"`
+	EndDate     string      `json:"endDate,omitempty"`
+	TimeZone    string      `json:"timeZone"`
+	Recurrence  *Recurrence `json:"recurrence,omitempty"`
+}
+
+// Recurrence rule of a schedule
+type Recurrence struct {
+	Type           RecurrenceType `json:"type"`
+	Interval       int            `json:"interval,omitempty"`
+	DaysOfWeek     []string       `json:"daysOfWeek,omitempty"`
+	DaysOfMonth    []int          `json:"daysOfMonth,omitempty"`
+	WeekOfTheMonth []int          `json:"weekOfTheMonth,omitempty"`
+}
+
+// Schedule returned by Turbonomic's API
+type Schedule struct {
+	UUID                    string      `json:"uuid"`
+	DisplayName             string      `json:"displayName"`
+	StartTime               string      `json:"startTime"`
+	EndTime                 string      `json:"endTime"`
+	StartDate               string      `json:"startDate,omitempty"`
+	EndDate                 string      `json:"endDate,omitempty"`
+	TimeZone                string      `json:"timeZone"`
+	Recurrence              *Recurrence `json:"recurrence,omitempty"`
+	NextOccurrence          string      `json:"nextOccurrence,omitempty"`
+	NextOccurrenceTimestamp int64       `json:"nextOccurrenceTimestamp,omitempty"`
+	ActiveSince             string      `json:"activeSince,omitempty"`
+	RemainingActiveTimeInMs int64       `json:"remainingActiveTimeInMs,omitempty"`
+}
+
+// Single occurrence of a schedule
+type ScheduleWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Retrives all schedules
+func (c *Client) GetSchedules(reqParams CommonReqParams) ([]Schedule, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/schedules", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []Schedule
+	if err := json.Unmarshal(restResp, &schedules); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// Retrives a schedule based on its provided uuid
+func (c *Client) GetSchedule(scheduleReq ScheduleRequest) (*Schedule, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/schedules/" + scheduleReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: scheduleReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var schedule Schedule
+	if err := json.Unmarshal(restResp, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Creates a one-time or recurring schedule
+func (c *Client) CreateSchedule(scheduleReq ScheduleInputRequest) (*Schedule, error) {
+	return c.sendSchedule("POST", "/schedules", scheduleReq)
+}
+
+// Updates the schedule with the provided uuid
+func (c *Client) UpdateSchedule(scheduleReq ScheduleInputRequest) (*Schedule, error) {
+	return c.sendSchedule("PUT", "/schedules/"+scheduleReq.Uuid, scheduleReq)
+}
+
+// Deletes the schedule with the provided uuid
+func (c *Client) DeleteSchedule(scheduleReq ScheduleRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/schedules/" + scheduleReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: scheduleReq.CommonReqParams})
+
+	return err
+}
+
+// Retrives the settings policies that reference the schedule with the provided uuid
+func (c *Client) GetSchedulePolicies(scheduleReq ScheduleRequest) ([]SettingsPolicy, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/schedules/" + scheduleReq.Uuid + "/settingspolicies",
+		ReqDTO: new(bytes.Buffer), CommonReqParams: scheduleReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []SettingsPolicy
+	if err := json.Unmarshal(restResp, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (c *Client) sendSchedule(method, urlPath string, scheduleReq ScheduleInputRequest) (*Schedule, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(scheduleReq.Schedule); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: scheduleReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var schedule Schedule
+	if err := json.Unmarshal(restResp, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Computes the first window of the schedule starting at or after the
+// provided time. A nil window is returned when the schedule has no further
+// occurrences.
+func (s Schedule) NextWindow(after time.Time) (*ScheduleWindow, error) {
+
+	loc := time.UTC
+	if s.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(s.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	start, err := parseScheduleTime(s.StartTime, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule start time: %w", err)
+	}
+	duration := time.Duration(0)
+	if s.EndTime != "" {
+		end, err := parseScheduleTime(s.EndTime, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule end time: %w", err)
+		}
+		duration = end.Sub(start)
+	}
+
+	if s.Recurrence == nil || s.Recurrence.Type == "" {
+		if start.Before(after) {
+			return nil, nil
+		}
+		return &ScheduleWindow{Start: start, End: start.Add(duration)}, nil
+	}
+
+	var lastDay time.Time
+	if s.EndDate != "" {
+		if lastDay, err = parseScheduleTime(s.EndDate, loc); err != nil {
+			return nil, fmt.Errorf("invalid schedule end date: %w", err)
+		}
+		lastDay = calendarDay(lastDay)
+	}
+
+	day := calendarDay(start)
+	if afterDay := calendarDay(after.In(loc)); afterDay.After(day) {
+		day = afterDay
+	}
+
+	for i := 0; i < scheduleSearchDays; i, day = i+1, day.AddDate(0, 0, 1) {
+		if !lastDay.IsZero() && day.After(lastDay) {
+			return nil, nil
+		}
+		if !s.Recurrence.matches(calendarDay(start), day) {
+			continue
+		}
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(),
+			start.Hour(), start.Minute(), start.Second(), 0, loc)
+		if occurrence.Before(after) || occurrence.Before(start) {
+			continue
+		}
+		return &ScheduleWindow{Start: occurrence, End: occurrence.Add(duration)}, nil
+	}
+
+	return nil, nil
+}
+
+// Reports whether the recurrence produces an occurrence on the provided day.
+// Both days are midnight UTC values representing calendar dates.
+func (r Recurrence) matches(first, day time.Time) bool {
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch r.Type {
+	case DAILY:
+		return daysBetween(first, day)%interval == 0
+	case WEEKLY:
+		if !r.matchesWeekday(first, day) {
+			return false
+		}
+		weeks := daysBetween(startOfWeek(first), startOfWeek(day)) / 7
+		return weeks%interval == 0
+	case MONTHLY:
+		months := (day.Year()-first.Year())*12 + int(day.Month()-first.Month())
+		if months%interval != 0 {
+			return false
+		}
+		daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		switch {
+		case len(r.DaysOfMonth) > 0:
+			return slices.ContainsFunc(r.DaysOfMonth, func(d int) bool {
+				return d == day.Day() || (d < 0 && daysInMonth+d+1 == day.Day())
+			})
+		case len(r.WeekOfTheMonth) > 0:
+			if !r.matchesWeekday(first, day) {
+				return false
+			}
+			week := (day.Day()-1)/7 + 1
+			last := day.Day()+7 > daysInMonth
+			return slices.ContainsFunc(r.WeekOfTheMonth, func(w int) bool {
+				return w == week || (w < 0 && last)
+			})
+		default:
+			return day.Day() == first.Day()
+		}
+	}
+	return false
+}
+
+func (r Recurrence) matchesWeekday(first, day time.Time) bool {
+	if len(r.DaysOfWeek) == 0 {
+		return day.Weekday() == first.Weekday()
+	}
+	weekday := day.Weekday().String()[:3]
+	return slices.ContainsFunc(r.DaysOfWeek, func(d string) bool {
+		return len(d) >= 3 && strings.EqualFold(d[:3], weekday)
+	})
+}
+
+func parseScheduleTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.In(loc), nil
+	}
+	var err error
+	for _, layout := range scheduleTimeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// Returns the calendar date of t as midnight UTC, so that day arithmetic is not
+// affected by daylight saving changes in the schedule's time zone
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -int(day.Weekday()))
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSchedule(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/schedules", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"displayName\":\"Weekend Maintenance\",\"startTime\":\"2026-01-03T22:00:00\","+
+			"\"endTime\":\"2026-01-04T02:00:00\",\"timeZone\":\"America/New_York\","+
+			"\"recurrence\":{\"type\":\"WEEKLY\",\"daysOfWeek\":[\"SAT\"]}}\n",
+			string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"285024589026999","displayName":"Weekend Maintenance",
+			"startTime":"2026-01-03T22:00:00","endTime":"2026-01-04T02:00:00","timeZone":"America/New_York",
+			"recurrence":{"type":"WEEKLY","daysOfWeek":["SAT"]},"nextOccurrence":"2026-01-03T22:00:00"}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	schedule, err := client.CreateSchedule(ScheduleInputRequest{Schedule: ScheduleInput{
+		DisplayName: "Weekend Maintenance",
+		StartTime:   "2026-01-03T22:00:00",
+		EndTime:     "2026-01-04T02:00:00",
+		TimeZone:    "America/New_York",
+		Recurrence:  &Recurrence{Type: WEEKLY, DaysOfWeek: []string{"SAT"}},
+	}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "285024589026999", schedule.UUID)
+	assert.Equal(t, WEEKLY, schedule.Recurrence.Type)
+}
+
+func TestGetSchedulePolicies(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/schedules/285024589026999/settingspolicies", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"uuid":"285024589026640","displayName":"AppInfra Automation",
+			"entityType":"VirtualMachine","schedule":{"uuid":"285024589026999"}}]`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	policies, err := client.GetSchedulePolicies(ScheduleRequest{Uuid: "285024589026999"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policies))
+	assert.Equal(t, "285024589026999", policies[0].Schedule.UUID)
+}
+
+func TestScheduleNextWindow(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal("Error when loading time zone: ", err)
+	}
+
+	tests := []struct {
+		name          string
+		schedule      Schedule
+		after         time.Time
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectNone    bool
+	}{
+		{
+			name:          "one time in the future",
+			schedule:      Schedule{StartTime: "2026-03-01T10:00:00", EndTime: "2026-03-01T12:00:00"},
+			after:         time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "one time in the past",
+			schedule:   Schedule{StartTime: "2026-03-01T10:00:00", EndTime: "2026-03-01T12:00:00"},
+			after:      time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			expectNone: true,
+		},
+		{
+			name: "every other day",
+			schedule: Schedule{StartTime: "2026-03-01T10:00:00", EndTime: "2026-03-01T11:00:00",
+				Recurrence: &Recurrence{Type: DAILY, Interval: 2}},
+			after:         time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2026, 3, 5, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly on saturday across daylight saving change",
+			schedule: Schedule{StartTime: "2026-01-03T22:00:00", EndTime: "2026-01-04T02:00:00",
+				TimeZone: "America/New_York", Recurrence: &Recurrence{Type: WEEKLY, DaysOfWeek: []string{"SAT"}}},
+			after:         time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 14, 22, 0, 0, 0, newYork),
+			expectedEnd:   time.Date(2026, 3, 15, 2, 0, 0, 0, newYork),
+		},
+		{
+			name: "every two weeks on monday and wednesday",
+			schedule: Schedule{StartTime: "2026-03-02T08:00:00", EndTime: "2026-03-02T09:00:00",
+				Recurrence: &Recurrence{Type: WEEKLY, Interval: 2, DaysOfWeek: []string{"MON", "WED"}}},
+			after:         time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 16, 8, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly on the last day",
+			schedule: Schedule{StartTime: "2026-01-31T23:00:00", EndTime: "2026-01-31T23:30:00",
+				Recurrence: &Recurrence{Type: MONTHLY, DaysOfMonth: []int{-1}}},
+			after:         time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2026, 2, 28, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly on the second tuesday",
+			schedule: Schedule{StartTime: "2026-01-13T20:00:00", EndTime: "2026-01-13T22:00:00",
+				Recurrence: &Recurrence{Type: MONTHLY, DaysOfWeek: []string{"TUE"}, WeekOfTheMonth: []int{2}}},
+			after:         time.Date(2026, 1, 14, 0, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 2, 10, 20, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2026, 2, 10, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "recurrence ended",
+			schedule: Schedule{StartTime: "2026-03-01T10:00:00", EndTime: "2026-03-01T11:00:00",
+				EndDate: "2026-03-10", Recurrence: &Recurrence{Type: DAILY}},
+			after:      time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+			expectNone: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := tt.schedule.NextWindow(tt.after)
+
+			assert.NoError(t, err)
+			if tt.expectNone {
+				assert.Nil(t, window)
+				return
+			}
+			assert.True(t, tt.expectedStart.Equal(window.Start), "got start %s", window.Start)
+			assert.True(t, tt.expectedEnd.Equal(window.End), "got end %s", window.End)
+		})
+	}
+}
+
+func TestScheduleNextWindowInvalidTime(t *testing.T) {
+	_, err := Schedule{StartTime: "next tuesday"}.NextWindow(time.Now())
+	assert.Error(t, err)
+}
@@ -17,8 +17,11 @@ package turboclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 var entityNameMap = map[string]string{
@@ -72,6 +75,15 @@ var entityNameMap = map[string]string{
 	"WorkloadController":       "workloadControllersByName",
 }
 
+// How the query of a quick search is matched against entity names
+type QueryType string
+
+const (
+	QUERY_EXACT    QueryType = "EXACT"
+	QUERY_CONTAINS QueryType = "CONTAINS"
+	QUERY_REGEX    QueryType = "REGEX"
+)
+
 // Parameters for searching Turbonomic's API
 type SearchRequest struct {
 	Name             string
@@ -83,6 +95,30 @@ type SearchRequest struct {
 	SearchParameters map[string]string
 }
 
+// Parameters for a quick search of Turbonomic's API by name. The query is a
+// literal name for the EXACT and CONTAINS query types, and a regular
+// expression for the REGEX query type.
+type QuickSearchRequest struct {
+	Query           string
+	QueryType       QueryType
+	EntityTypes     []string
+	ScopeUuids      []string
+	State           string
+	EnvironmentType string
+	// Cursor of the page to retrive, as returned by the previous page
+	Cursor          string
+	Limit           int
+	CommonReqParams CommonReqParams
+}
+
+// Page of results of a quick search
+type SearchPage struct {
+	Results SearchResults
+	// Cursor of the next page, empty on the last page
+	NextCursor       string
+	TotalRecordCount int
+}
+
 // Criterion for a Turbonomic API search request
 type Criteria struct {
 	CaseSensitive bool   `json:"caseSensitive"`
@@ -99,10 +135,32 @@ type SearchDTO struct {
 	Scope           string     `json:"scope,omitempty"`
 	EnvironmentType string     `json:"environmentType,omitempty"`
 	CloudType       string     `json:"cloudType,omitempty"`
+	// Groups or entities the search is restricted to, sent along with Scope
+	Scopes []string `json:"-"`
+}
+
+// Sends the scope as a list when more than one scope is provided
+func (s SearchDTO) MarshalJSON() ([]byte, error) {
+	type searchDTO SearchDTO
+	if len(s.Scopes) == 0 {
+		return json.Marshal(searchDTO(s))
+	}
+
+	scopes := s.Scopes
+	if s.Scope != "" {
+		scopes = append([]string{s.Scope}, scopes...)
+	}
+	return json.Marshal(struct {
+		searchDTO
+		Scope []string `json:"scope"`
+	}{searchDTO(s), scopes})
 }
 
 // Results of a search request to Turbonomic's API
-type SearchResults []struct {
+type SearchResults []SearchResult
+
+// Entity returned by a search request to Turbonomic's API
+type SearchResult struct {
 	UUID            string `json:"uuid"`
 	DisplayName     string `json:"displayName"`
 	ClassName       string `json:"className"`
@@ -114,11 +172,11 @@ type SearchResults []struct {
 		Type        string `json:"type"`
 		Readonly    bool   `json:"readonly"`
 	} `json:"discoveredBy"`
-	VendorIds         struct{} `json:"vendorIds"`
-	State             string   `json:"state"`
-	Severity          string   `json:"severity"`
-	CostPrice         float64  `json:"costPrice"`
-	SeverityBreakdown struct{} `json:"severityBreakdown"`
+	VendorIds         map[string]string `json:"vendorIds"`
+	State             string            `json:"state"`
+	Severity          string            `json:"severity"`
+	CostPrice         float64           `json:"costPrice"`
+	SeverityBreakdown struct{}          `json:"severityBreakdown"`
 	Template          struct {
 		Price       float64 `json:"price"`
 		Discovered  bool    `json:"discovered"`
@@ -177,51 +235,35 @@ type SearchResults []struct {
 					DisplayName string `json:"displayName"`
 					ClassName   string `json:"className"`
 				} `json:"dataCenter"`
-				EnvironmentType string `json:"environmentType"`
-				LastModified    int64  `json:"lastModified"`
-				BusinessAccount struct {
-					UUID            string `json:"uuid"`
-					DisplayName     string `json:"displayName"`
-					ClassName       string `json:"className"`
-					EnvironmentType string `json:"environmentType"`
-					DiscoveredBy    struct {
-						UUID        string `json:"uuid"`
-						DisplayName string `json:"displayName"`
-						Category    string `json:"category"`
-						Type        string `json:"type"`
-						Readonly    bool   `json:"readonly"`
-					} `json:"discoveredBy"`
-					VendorIds struct {
-						Turbonomicamp string `json:"turbonomicamp"`
-					} `json:"vendorIds"`
-					State             string `json:"state"`
-					Severity          string `json:"severity"`
-					SeverityBreakdown struct {
-						NORMAL int `json:"NORMAL"`
-					} `json:"severityBreakdown"`
-					Tags struct {
-						Usage []string `json:"Usage"`
-					} `json:"tags"`
-					Staleness string `json:"staleness"`
-				} `json:"businessAccount"`
-				SnapshotID        string  `json:"snapshotId"`
-				Encryption        string  `json:"encryption"`
-				AttachmentState   string  `json:"attachmentState"`
-				HourlyBilledOps   float64 `json:"hourlyBilledOps"`
-				CreationTimeStamp int64   `json:"creationTimeStamp"`
-				ResourceID        string  `json:"resourceId"`
+				EnvironmentType   string          `json:"environmentType"`
+				LastModified      int64           `json:"lastModified"`
+				BusinessAccount   BusinessAccount `json:"businessAccount"`
+				SnapshotID        string          `json:"snapshotId"`
+				Encryption        string          `json:"encryption"`
+				AttachmentState   string          `json:"attachmentState"`
+				HourlyBilledOps   float64         `json:"hourlyBilledOps"`
+				CreationTimeStamp int64           `json:"creationTimeStamp"`
+				ResourceID        string          `json:"resourceId"`
 			} `json:"virtualDisks"`
 			Type string `json:"type"`
 		} `json:"virtualDisksAspect"`
 	} `json:"aspects"`
-	Tags struct{} `json:"tags"`
+	Tags map[string][]string `json:"tags"`
+}
+
+// Returns the uuids of the entities in the search results
+func (r SearchResults) Uuids() []string {
+	uuids := make([]string, len(r))
+	for i, result := range r {
+		uuids[i] = result.UUID
+	}
+	return uuids
 }
 
 // Retrives the results of a search of Turbonomic's API based on provided parameters
 func (c *Client) SearchEntityByName(searchReq SearchRequest) (SearchResults, error) {
 
-	// var filterType string
-	filterType, err := c.getFilterType(searchReq.EntityType)
+	filterType, err := c.getFilterType(searchReq.EntityType, searchReq.CommonReqParams)
 	if err != nil {
 		return SearchResults{}, err
 	}
@@ -247,9 +289,60 @@ func (c *Client) SearchEntityByName(searchReq SearchRequest) (SearchResults, err
 func (c *Client) SearchEntities(
 	searchCriteria SearchDTO, reqParams CommonReqParams) (SearchResults, error) {
 
+	var searchResults SearchResults
+	if err := c.search(searchCriteria, reqParams, &searchResults); err != nil {
+		return nil, err
+	}
+
+	return searchResults, nil
+}
+
+// Retrives the entities matching a search built with NewSearch. Name criteria
+// use the filters discovered from the server. When the search expands into
+// several searches, for several entity types or alternatives, all pages of
+// each are retrived and merged, keeping each entity once.
+func (c *Client) Search(search *SearchBuilder, reqParams CommonReqParams) (SearchResults, error) {
+
+	searches, err := search.buildAll(func(entityType EntityType) string {
+		filterType, _ := c.getFilterType(string(entityType), reqParams)
+		return filterType
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(searches) == 1 {
+		return c.SearchEntities(searches[0], reqParams)
+	}
+
+	searchResults := SearchResults{}
+	seen := map[string]bool{}
+	for _, searchCriteria := range searches {
+		results, err := c.searchAllEntities(searchCriteria, reqParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			if !seen[result.UUID] {
+				seen[result.UUID] = true
+				searchResults = append(searchResults, result)
+			}
+		}
+	}
+
+	return searchResults, nil
+}
+
+// Validates and sends the search, decoding the results into the provided
+// value, such as SearchResults or groups
+func (c *Client) search(searchCriteria SearchDTO, reqParams CommonReqParams, results any) error {
+
+	if err := c.validateSearch(searchCriteria, reqParams); err != nil {
+		return err
+	}
+
 	dtoBuf := new(bytes.Buffer)
 	if err := json.NewEncoder(dtoBuf).Encode(searchCriteria); err != nil {
-		return nil, err
+		return err
 	}
 
 	restResp, err := c.request(RequestOptions{Method: "POST", Path: "/search", ReqDTO: dtoBuf,
@@ -257,24 +350,88 @@ func (c *Client) SearchEntities(
 			Headers:         reqParams.Headers,
 			QueryParameters: reqParams.QueryParameters}})
 	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(restResp, results)
+}
+
+// Retrives all the entities matching the search criteria, following the
+// cursor of each page until the last one
+func (c *Client) searchAllEntities(searchCriteria SearchDTO, reqParams CommonReqParams) (SearchResults, error) {
+
+	if err := c.validateSearch(searchCriteria, reqParams); err != nil {
 		return nil, err
 	}
 
-	var searchResults SearchResults
-	if err := json.Unmarshal(restResp, &searchResults); err != nil {
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(searchCriteria); err != nil {
 		return nil, err
 	}
 
-	return searchResults, err
+	searchResults := SearchResults{}
+	cursors := map[string]bool{}
+	cursor := ""
+	for {
+		restResp, headers, err := c.requestWithHeaders(context.Background(), RequestOptions{Method: "POST", Path: "/search",
+			ReqDTO: bytes.NewBuffer(dtoBuf.Bytes()), CommonReqParams: withCursor(reqParams, cursor)})
+		if err != nil {
+			return nil, err
+		}
+
+		var page SearchResults
+		if err := json.Unmarshal(restResp, &page); err != nil {
+			return nil, err
+		}
+		searchResults = append(searchResults, page...)
+
+		// A cursor seen before would page through the same results again
+		cursor = headers.Get("X-Next-Cursor")
+		if cursor == "" || cursors[cursor] {
+			return searchResults, nil
+		}
+		cursors[cursor] = true
+	}
 }
 
-// Helper function to enable the use of entity type as the filter instead of
-// longer parameter names required by Turbonomic's API
-func (c *Client) getFilterType(entityType string) (string, error) {
+// Retrives a page of the entities whose name matches the query, across the
+// provided entity types and scopes
+func (c *Client) QuickSearch(searchReq QuickSearchRequest) (*SearchPage, error) {
+
+	query := searchReq.Query
+	if searchReq.QueryType == QUERY_EXACT || searchReq.QueryType == QUERY_CONTAINS {
+		// Turbonomic matches every query type as a regular expression
+		query = regexp.QuoteMeta(query)
+	}
+
+	queryParameters := map[string]string{
+		"q":                query,
+		"types":            strings.Join(searchReq.EntityTypes, ","),
+		"scopes":           strings.Join(searchReq.ScopeUuids, ","),
+		"state":            searchReq.State,
+		"environment_type": searchReq.EnvironmentType,
+		"query_type":       string(searchReq.QueryType),
+		"cursor":           searchReq.Cursor,
+	}
+	if searchReq.Limit > 0 {
+		queryParameters["limit"] = strconv.Itoa(searchReq.Limit)
+	}
+
+	restResp, headers, err := c.requestWithHeaders(context.Background(), RequestOptions{Method: "GET", Path: "/search",
+		ReqDTO: new(bytes.Buffer), CommonReqParams: withQueryParameters(searchReq.CommonReqParams, queryParameters)})
+	if err != nil {
+		return nil, err
+	}
 
-	filterType := entityNameMap[entityType]
-	if filterType != "" {
-		return filterType, nil
+	page := SearchPage{NextCursor: headers.Get("X-Next-Cursor")}
+	if total := headers.Get("X-Total-Record-Count"); total != "" {
+		if page.TotalRecordCount, err = strconv.Atoi(total); err != nil {
+			return nil, err
+		}
 	}
-	return "", fmt.Errorf("entity type of %s not supported", entityType)
+	if err := json.Unmarshal(restResp, &page.Results); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
 }
@@ -25,6 +25,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -48,6 +49,9 @@ func TestSearchEntities(t *testing.T) {
 
 	// Create a test server with the mock response
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
 		assert.Equal(t, "POST", r.Method)
 		assert.Equal(t, "/search", r.URL.Path)
 		body, _ := io.ReadAll(r.Body)
@@ -102,6 +106,9 @@ func TestSearchEntityByName(t *testing.T) {
 
 	// Create a test server with the mock response
 	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
 		assert.Equal(t, "POST", r.Method)
 		assert.Equal(t, "/search", r.URL.Path)
 		body, _ := io.ReadAll(r.Body)
@@ -178,3 +185,256 @@ func TestSearchEntitiesIntegration(t *testing.T) {
 	}
 
 }
+
+// Answers requests for the search criteria with the mock criteria, returning
+// whether the request was handled
+func serveSearchCriteria(t *testing.T, w http.ResponseWriter, r *http.Request) bool {
+	var response []byte
+	switch r.URL.Path {
+	case "/search/criteria":
+		mockResponse, err := os.ReadFile("./testfiles/SearchCriteria.json")
+		if err != nil {
+			t.Fatal("Error when opening file: ", err)
+		}
+		response = mockResponse
+	case "/search/criteria/vmsByState/options":
+		response = []byte(`[{"value":"ACTIVE","displayName":"Active"},{"value":"IDLE","displayName":"Idle"}]`)
+	default:
+		return false
+	}
+
+	assert.Equal(t, "GET", r.Method)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(response); err != nil {
+		t.Errorf("failed to write response: %s", err.Error())
+	}
+	return true
+}
+
+func TestSearchCriteriaDiscovery(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	requests := map[string]int{}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"filterType":"gpusByName"`)
+		if _, err := w.Write([]byte("[]")); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	// Entity types unknown to this library are searched with the discovered filters
+	_, err := client.SearchEntityByName(SearchRequest{Name: "gpu-1", EntityType: "GPU"})
+	assert.NoError(t, err)
+
+	criteria, err := client.GetSearchCriteria(CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(criteria))
+	assert.Equal(t, "VirtualMachine", criteria[1].EntityType)
+	assert.True(t, criteria[1].LoadOptions)
+
+	options, err := client.GetSearchCriteriaOptions("vmsByState", CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []CriteriaOption{{Value: "ACTIVE", DisplayName: "Active"}, {Value: "IDLE", DisplayName: "Idle"}}, options)
+	_, err = client.GetSearchCriteriaOptions("vmsByState", CommonReqParams{})
+	assert.NoError(t, err)
+
+	// Criteria and options are cached
+	assert.Equal(t, 1, requests["/search/criteria"])
+	assert.Equal(t, 1, requests["/search/criteria/vmsByState/options"])
+	assert.Equal(t, 1, requests["/search"])
+}
+
+func TestSearchEntitiesValidation(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
+		assert.Equal(t, "/search", r.URL.Path)
+		if _, err := w.Write([]byte("[]")); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	tests := []struct {
+		name     string
+		criteria SearchDTO
+		err      string
+	}{
+		{"valid", SearchDTO{ClassName: "VirtualMachine", LogicalOperator: "AND", CriteriaList: []Criteria{
+			{ExpType: "RXEQ", ExpVal: "prod-.*", FilterType: "vmsByName"},
+			{ExpType: "EQ", ExpVal: "ACTIVE|IDLE", FilterType: "vmsByState"},
+		}}, ""},
+		{"unknown entity type", SearchDTO{ClassName: "Mainframe", LogicalOperator: "AND"},
+			"entity type of Mainframe not supported"},
+		{"unknown filter", SearchDTO{ClassName: "VirtualMachine", LogicalOperator: "AND", CriteriaList: []Criteria{
+			{ExpType: "EQ", ExpVal: "x", FilterType: "vmsByColour"},
+		}}, "filter type vmsByColour not supported"},
+		{"filter of another entity type", SearchDTO{ClassName: "VirtualMachine", LogicalOperator: "AND", CriteriaList: []Criteria{
+			{ExpType: "EQ", ExpVal: "x", FilterType: "pmsByName"},
+		}}, "filter type pmsByName does not apply to entity type VirtualMachine"},
+		{"unknown expression type", SearchDTO{ClassName: "VirtualMachine", LogicalOperator: "AND", CriteriaList: []Criteria{
+			{ExpType: "LIKE", ExpVal: "x", FilterType: "vmsByName"},
+		}}, "expression type LIKE of filter vmsByName not supported"},
+		{"value not in options", SearchDTO{ClassName: "VirtualMachine", LogicalOperator: "AND", CriteriaList: []Criteria{
+			{ExpType: "EQ", ExpVal: "ACTIVE|RUNNING", FilterType: "vmsByState"},
+		}}, "value RUNNING of filter vmsByState not supported"},
+	}
+
+	for _, tt := range tests {
+		_, err := client.SearchEntities(tt.criteria, CommonReqParams{})
+		if tt.err == "" {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.EqualError(t, err, tt.err, tt.name)
+		}
+	}
+}
+
+func TestSearchEntityByNameWithoutDiscovery(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	discoveries := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/criteria" {
+			discoveries++
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"filterType":"pmsByName"`)
+		if _, err := w.Write([]byte("[]")); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	// The filters known to this library are used when discovery is unavailable
+	_, err := client.SearchEntityByName(SearchRequest{Name: "host-1", EntityType: "PhysicalMachine"})
+	assert.NoError(t, err)
+
+	_, err = client.SearchEntityByName(SearchRequest{Name: "gpu-1", EntityType: "GPU"})
+	assert.EqualError(t, err, "entity type of GPU not supported")
+
+	// The failed discovery is cached until it is retried
+	_, err = client.GetSearchCriteria(CommonReqParams{})
+	assert.ErrorContains(t, err, "discovering search criteria")
+	assert.Equal(t, 1, discoveries)
+
+	client.searchCriteria.retryAt = time.Now()
+	_, err = client.SearchEntityByName(SearchRequest{Name: "host-1", EntityType: "PhysicalMachine"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, discoveries)
+}
+
+func TestQuickSearch(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/search", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "VirtualMachine,Database", query.Get("types"))
+		assert.Equal(t, "123,456", query.Get("scopes"))
+		assert.Equal(t, "ACTIVE", query.Get("state"))
+		assert.Equal(t, "CLOUD", query.Get("environment_type"))
+
+		var response string
+		switch query.Get("query_type") {
+		case "EXACT":
+			// Literal names are escaped
+			assert.Equal(t, `prod-db\.1`, query.Get("q"))
+			assert.Equal(t, "", query.Get("cursor"))
+			assert.Equal(t, "1", query.Get("limit"))
+			w.Header().Set("X-Next-Cursor", "1")
+			w.Header().Set("X-Total-Record-Count", "2")
+			response = `[{"uuid":"1","displayName":"prod-db.1","className":"VirtualMachine"}]`
+		case "REGEX":
+			assert.Equal(t, "prod-db-.*", query.Get("q"))
+			assert.Equal(t, "1", query.Get("cursor"))
+			w.Header().Set("X-Total-Record-Count", "2")
+			response = `[{"uuid":"2","displayName":"prod-db-2","className":"Database"}]`
+		default:
+			t.Errorf("unexpected query type %s", query.Get("query_type"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	searchReq := QuickSearchRequest{
+		Query:           "prod-db.1",
+		QueryType:       QUERY_EXACT,
+		EntityTypes:     []string{"VirtualMachine", "Database"},
+		ScopeUuids:      []string{"123", "456"},
+		State:           "ACTIVE",
+		EnvironmentType: "CLOUD",
+		Limit:           1,
+	}
+
+	page, err := client.QuickSearch(searchReq)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, page.Results.Uuids())
+	assert.Equal(t, "1", page.NextCursor)
+	assert.Equal(t, 2, page.TotalRecordCount)
+
+	searchReq.Query = "prod-db-.*"
+	searchReq.QueryType = QUERY_REGEX
+	searchReq.Limit = 0
+	searchReq.Cursor = page.NextCursor
+
+	page, err = client.QuickSearch(searchReq)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, page.Results.Uuids())
+	assert.Equal(t, "", page.NextCursor)
+	assert.Equal(t, 2, page.TotalRecordCount)
+}
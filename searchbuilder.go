@@ -0,0 +1,358 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Types of entities searched by a SearchBuilder
+type EntityType string
+
+const (
+	EntityTypeVM                 EntityType = "VirtualMachine"
+	EntityTypeHost               EntityType = "PhysicalMachine"
+	EntityTypeStorage            EntityType = "Storage"
+	EntityTypeVolume             EntityType = "VirtualVolume"
+	EntityTypeDatabase           EntityType = "Database"
+	EntityTypeDatabaseServer     EntityType = "DatabaseServer"
+	EntityTypeApplication        EntityType = "ApplicationComponent"
+	EntityTypeService            EntityType = "Service"
+	EntityTypeContainer          EntityType = "Container"
+	EntityTypeContainerPod       EntityType = "ContainerPod"
+	EntityTypeWorkloadController EntityType = "WorkloadController"
+	EntityTypeNamespace          EntityType = "Namespace"
+)
+
+// Environments of the entities searched by a SearchBuilder
+type EnvironmentType string
+
+const (
+	CLOUD  EnvironmentType = "CLOUD"
+	ONPREM EnvironmentType = "ONPREM"
+	HYBRID EnvironmentType = "HYBRID"
+)
+
+// Expressions comparing the value of a search criterion
+type ExpType string
+
+const (
+	EXP_EQ    ExpType = "EQ"
+	EXP_NEQ   ExpType = "NEQ"
+	EXP_RXEQ  ExpType = "RXEQ"
+	EXP_RXNEQ ExpType = "RXNEQ"
+	EXP_GT    ExpType = "GT"
+	EXP_GTE   ExpType = "GTE"
+	EXP_LT    ExpType = "LT"
+	EXP_LTE   ExpType = "LTE"
+)
+
+// Opposite of each expression, used to negate criteria
+var negatedExpTypes = map[ExpType]ExpType{
+	EXP_EQ: EXP_NEQ, EXP_NEQ: EXP_EQ,
+	EXP_RXEQ: EXP_RXNEQ, EXP_RXNEQ: EXP_RXEQ,
+	EXP_GT: EXP_LTE, EXP_LTE: EXP_GT,
+	EXP_GTE: EXP_LT, EXP_LT: EXP_GTE,
+}
+
+var clusterFilterMap = map[EntityType]string{
+	EntityTypeVM:   "vmsByClusterName",
+	EntityTypeHost: "pmsByClusterName",
+}
+
+var tagFilterMap = map[EntityType]string{
+	EntityTypeVM:             "vmsByTag",
+	EntityTypeHost:           "pmsByTag",
+	EntityTypeVolume:         "virtualVolumeByTag",
+	EntityTypeDatabase:       "databaseByTag",
+	EntityTypeDatabaseServer: "databaseServerByTag",
+}
+
+// Builds the SearchDTO of a search of one or more entity types. Criteria are
+// all combined with AND, or all with OR, as Turbonomic does not support
+// nesting them, so the operator is chosen before any criterion is added.
+// Alternatives passed to Or are expanded into one search each, whose
+// results are merged by Search. Errors are reported when the search is built.
+type SearchBuilder struct {
+	entityTypes     []EntityType
+	criteria        []searchTerm
+	alternatives    []*SearchBuilder
+	logicalOperator string
+	environmentType EnvironmentType
+	cloudType       string
+	scopes          []string
+	caseSensitive   bool
+	negate          bool
+	err             error
+}
+
+// Criterion of a search, resolved for each entity type searched. The filter
+// of name criteria is resolved when the search is built, as the server may
+// name entity types unknown to this library.
+type searchTerm struct {
+	filterTypes map[EntityType]string
+	byName      bool
+	name        string
+	expType     ExpType
+	expVal      string
+}
+
+// Starts a search of entities of the provided types
+func NewSearch(entityTypes ...EntityType) *SearchBuilder {
+	return &SearchBuilder{entityTypes: entityTypes, logicalOperator: "AND"}
+}
+
+// Negates the criterion added next
+func (b *SearchBuilder) Not() *SearchBuilder {
+	b.negate = !b.negate
+	return b
+}
+
+// Matches entities satisfying all criteria, which is the default
+func (b *SearchBuilder) And() *SearchBuilder {
+	return b.combine("AND")
+}
+
+// Matches entities satisfying the criteria of this search or those of any of
+// the alternatives, such as NewSearch().InCluster("cluster-2"). Alternatives
+// only add criteria, the entity types, scopes and environment being those of
+// this search. Without alternatives, all criteria are combined with OR.
+func (b *SearchBuilder) Or(alternatives ...*SearchBuilder) *SearchBuilder {
+	if len(alternatives) == 0 {
+		return b.combine("OR")
+	}
+	for _, alternative := range alternatives {
+		if alternative == nil || alternative == b {
+			return b.fail(errors.New("alternative must be another search"))
+		}
+		if len(alternative.entityTypes) > 0 || len(alternative.scopes) > 0 ||
+			alternative.environmentType != "" || alternative.cloudType != "" {
+			return b.fail(errors.New("alternative searches only add criteria"))
+		}
+	}
+	b.alternatives = append(b.alternatives, alternatives...)
+	return b
+}
+
+// Matches names and other string values with their case
+func (b *SearchBuilder) CaseSensitive() *SearchBuilder {
+	b.caseSensitive = true
+	return b
+}
+
+// Matches entities with the provided name
+func (b *SearchBuilder) NameEquals(name string) *SearchBuilder {
+	return b.add(searchTerm{byName: true, name: "name", expType: EXP_EQ, expVal: name})
+}
+
+// Matches entities whose name matches the provided regular expression
+func (b *SearchBuilder) NameMatches(pattern string) *SearchBuilder {
+	return b.add(searchTerm{byName: true, name: "name", expType: EXP_RXEQ, expVal: pattern})
+}
+
+// Matches entities in the cluster with the provided name
+func (b *SearchBuilder) InCluster(clusterName string) *SearchBuilder {
+	return b.add(searchTerm{filterTypes: clusterFilterMap, name: "cluster", expType: EXP_EQ, expVal: clusterName})
+}
+
+// Matches entities tagged with the provided key and any of the values
+func (b *SearchBuilder) WithTag(key string, values ...string) *SearchBuilder {
+	if key == "" {
+		return b.fail(errors.New("tag key is required"))
+	}
+	if len(values) == 0 {
+		return b.fail(fmt.Errorf("values of tag %s are required", key))
+	}
+	return b.add(searchTerm{filterTypes: tagFilterMap, name: "tag", expType: EXP_EQ, expVal: key + "=" + strings.Join(values, "|")})
+}
+
+// Matches entities tagged with the provided key, whatever its values
+func (b *SearchBuilder) WithTagKey(key string) *SearchBuilder {
+	if key == "" {
+		return b.fail(errors.New("tag key is required"))
+	}
+	return b.add(searchTerm{filterTypes: tagFilterMap, name: "tag", expType: EXP_RXEQ, expVal: regexp.QuoteMeta(key) + "=.*"})
+}
+
+// Matches entities whose string value of the provided filter type compares
+// to the value. The filter type is used for every entity type searched.
+func (b *SearchBuilder) Where(filterType string, expType ExpType, value string) *SearchBuilder {
+	if _, ok := negatedExpTypes[expType]; !ok {
+		return b.fail(fmt.Errorf("expression type %s not supported", expType))
+	}
+	return b.add(searchTerm{name: filterType, expType: expType, expVal: value})
+}
+
+// Matches entities whose numeric value of the provided filter type compares
+// to the value, such as vmsByNumCPUs greater than 4
+func (b *SearchBuilder) Compare(filterType string, expType ExpType, value float64) *SearchBuilder {
+	if expType == EXP_RXEQ || expType == EXP_RXNEQ {
+		return b.fail(fmt.Errorf("expression type %s does not compare numbers", expType))
+	}
+	return b.Where(filterType, expType, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// Restricts the search to entities of the provided environment
+func (b *SearchBuilder) EnvironmentType(environmentType EnvironmentType) *SearchBuilder {
+	b.environmentType = environmentType
+	return b
+}
+
+// Restricts the search to entities of the provided cloud, such as AWS
+func (b *SearchBuilder) CloudType(cloudType string) *SearchBuilder {
+	b.cloudType = cloudType
+	return b
+}
+
+// Restricts the search to the provided groups or entities
+func (b *SearchBuilder) InScope(scopeUuids ...string) *SearchBuilder {
+	b.scopes = append(b.scopes, scopeUuids...)
+	return b
+}
+
+// Returns the search of a single entity type without alternatives
+func (b *SearchBuilder) Build() (SearchDTO, error) {
+	if len(b.entityTypes) > 1 {
+		return SearchDTO{}, fmt.Errorf("search of %d entity types must be built with BuildAll", len(b.entityTypes))
+	}
+
+	searches, err := b.BuildAll()
+	if err != nil {
+		return SearchDTO{}, err
+	}
+	if len(searches) > 1 {
+		return SearchDTO{}, fmt.Errorf("search with %d alternatives must be built with BuildAll", len(searches)-1)
+	}
+	return searches[0], nil
+}
+
+// Returns one search for each entity type and each alternative, as a search
+// only matches entities of a single type and cannot nest criteria. An entity
+// matches the built search when it matches any of the returned searches.
+// Name criteria use the filters known to this library, whereas Search uses
+// those discovered from the server.
+func (b *SearchBuilder) BuildAll() ([]SearchDTO, error) {
+	return b.buildAll(func(entityType EntityType) string {
+		return entityNameMap[string(entityType)]
+	})
+}
+
+// Builds the searches, resolving the filter of name criteria for each entity
+// type with the provided function, which returns an empty filter when the
+// entity type has none
+func (b *SearchBuilder) buildAll(nameFilter func(EntityType) string) ([]SearchDTO, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.entityTypes) == 0 {
+		return nil, errors.New("entity type is required")
+	}
+
+	branches, err := b.branches()
+	if err != nil {
+		return nil, err
+	}
+
+	searches := make([]SearchDTO, 0, len(b.entityTypes)*len(branches))
+	for _, entityType := range b.entityTypes {
+		for _, branch := range branches {
+			search := SearchDTO{
+				CriteriaList:    []Criteria{},
+				LogicalOperator: branch.logicalOperator,
+				ClassName:       string(entityType),
+				Scopes:          b.scopes,
+				EnvironmentType: string(b.environmentType),
+				CloudType:       b.cloudType,
+			}
+
+			for _, term := range branch.criteria {
+				filterType := term.name
+				switch {
+				case term.byName:
+					filterType = nameFilter(entityType)
+				case term.filterTypes != nil:
+					filterType = term.filterTypes[entityType]
+				}
+				if filterType == "" {
+					return nil, fmt.Errorf("%s criterion not supported for entity type %s", term.name, entityType)
+				}
+				search.CriteriaList = append(search.CriteriaList, Criteria{
+					CaseSensitive: branch.caseSensitive,
+					ExpType:       string(term.expType),
+					ExpVal:        term.expVal,
+					FilterType:    filterType,
+				})
+			}
+
+			searches = append(searches, search)
+		}
+	}
+
+	return searches, nil
+}
+
+// Returns this search followed by its alternatives and theirs, each being
+// sent as a separate search
+func (b *SearchBuilder) branches() ([]*SearchBuilder, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.negate {
+		return nil, errors.New("negation is not followed by a criterion")
+	}
+
+	branches := []*SearchBuilder{b}
+	for _, alternative := range b.alternatives {
+		alternativeBranches, err := alternative.branches()
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, alternativeBranches...)
+	}
+	return branches, nil
+}
+
+// Sets the operator combining all criteria, which cannot change once criteria
+// were added as it would silently apply to them too
+func (b *SearchBuilder) combine(logicalOperator string) *SearchBuilder {
+	if len(b.criteria) > 0 && b.logicalOperator != logicalOperator {
+		return b.fail(fmt.Errorf("%s must be chosen before adding criteria combined with %s", logicalOperator, b.logicalOperator))
+	}
+	b.logicalOperator = logicalOperator
+	return b
+}
+
+func (b *SearchBuilder) add(term searchTerm) *SearchBuilder {
+	if b.negate {
+		term.expType = negatedExpTypes[term.expType]
+		b.negate = false
+	}
+	b.criteria = append(b.criteria, term)
+	return b
+}
+
+// Keeps the first error, reported when the search is built
+func (b *SearchBuilder) fail(err error) *SearchBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+	b.negate = false
+	return b
+}
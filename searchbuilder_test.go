@@ -0,0 +1,323 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchBuilder(t *testing.T) {
+	search, err := NewSearch(EntityTypeVM).
+		NameMatches("prod-.*").
+		InCluster("cluster-1").
+		WithTag("team", "db").
+		Not().Compare("vmsByNumCPUs", EXP_GT, 4).
+		EnvironmentType(ONPREM).
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, SearchDTO{
+		CriteriaList: []Criteria{
+			{ExpType: "RXEQ", ExpVal: "prod-.*", FilterType: "vmsByName"},
+			{ExpType: "EQ", ExpVal: "cluster-1", FilterType: "vmsByClusterName"},
+			{ExpType: "EQ", ExpVal: "team=db", FilterType: "vmsByTag"},
+			{ExpType: "LTE", ExpVal: "4", FilterType: "vmsByNumCPUs"},
+		},
+		LogicalOperator: "AND",
+		ClassName:       "VirtualMachine",
+		EnvironmentType: "ONPREM",
+	}, search)
+
+	search, err = NewSearch(EntityTypeHost).Or().CaseSensitive().
+		NameEquals("host-1").
+		Not().NameMatches("test-.*").
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "OR", search.LogicalOperator)
+	assert.Equal(t, []Criteria{
+		{CaseSensitive: true, ExpType: "EQ", ExpVal: "host-1", FilterType: "pmsByName"},
+		{CaseSensitive: true, ExpType: "RXNEQ", ExpVal: "test-.*", FilterType: "pmsByName"},
+	}, search.CriteriaList)
+
+	search, err = NewSearch(EntityTypeVM).WithTag("team", "db", "web").WithTagKey("cost.center").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, []Criteria{
+		{ExpType: "EQ", ExpVal: "team=db|web", FilterType: "vmsByTag"},
+		{ExpType: "RXEQ", ExpVal: `cost\.center=.*`, FilterType: "vmsByTag"},
+	}, search.CriteriaList)
+}
+
+func TestSearchBuilderMultipleTypes(t *testing.T) {
+	builder := NewSearch(EntityTypeVM, EntityTypeDatabase).
+		WithTag("owner", "alice").
+		InScope("123", "456").
+		EnvironmentType(CLOUD).
+		CloudType("AWS")
+
+	_, err := builder.Build()
+	assert.EqualError(t, err, "search of 2 entity types must be built with BuildAll")
+
+	searches, err := builder.BuildAll()
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(searches)) {
+		assert.Equal(t, "VirtualMachine", searches[0].ClassName)
+		assert.Equal(t, "vmsByTag", searches[0].CriteriaList[0].FilterType)
+		assert.Equal(t, "Database", searches[1].ClassName)
+		assert.Equal(t, "databaseByTag", searches[1].CriteriaList[0].FilterType)
+		assert.Equal(t, "owner=alice", searches[1].CriteriaList[0].ExpVal)
+		assert.Equal(t, []string{"123", "456"}, searches[1].Scopes)
+		assert.Equal(t, "AWS", searches[1].CloudType)
+	}
+}
+
+func TestSearchBuilderAlternatives(t *testing.T) {
+	builder := NewSearch(EntityTypeVM).
+		NameMatches("prod-.*").
+		InCluster("cluster-1").
+		WithTag("team", "db").
+		EnvironmentType(CLOUD).
+		Or(NewSearch().InCluster("cluster-2"), NewSearch().Or().CaseSensitive().NameEquals("db-1").NameEquals("db-2"))
+
+	_, err := builder.Build()
+	assert.EqualError(t, err, "search with 2 alternatives must be built with BuildAll")
+
+	searches, err := builder.BuildAll()
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchDTO{
+		{
+			CriteriaList: []Criteria{
+				{ExpType: "RXEQ", ExpVal: "prod-.*", FilterType: "vmsByName"},
+				{ExpType: "EQ", ExpVal: "cluster-1", FilterType: "vmsByClusterName"},
+				{ExpType: "EQ", ExpVal: "team=db", FilterType: "vmsByTag"},
+			},
+			LogicalOperator: "AND", ClassName: "VirtualMachine", EnvironmentType: "CLOUD",
+		},
+		{
+			CriteriaList:    []Criteria{{ExpType: "EQ", ExpVal: "cluster-2", FilterType: "vmsByClusterName"}},
+			LogicalOperator: "AND", ClassName: "VirtualMachine", EnvironmentType: "CLOUD",
+		},
+		{
+			CriteriaList: []Criteria{
+				{CaseSensitive: true, ExpType: "EQ", ExpVal: "db-1", FilterType: "vmsByName"},
+				{CaseSensitive: true, ExpType: "EQ", ExpVal: "db-2", FilterType: "vmsByName"},
+			},
+			LogicalOperator: "OR", ClassName: "VirtualMachine", EnvironmentType: "CLOUD",
+		},
+	}, searches)
+
+	// Each entity type is searched with every alternative
+	searches, err = NewSearch(EntityTypeVM, EntityTypeHost).InCluster("cluster-1").Or(NewSearch().InCluster("cluster-2")).BuildAll()
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(searches)) {
+		assert.Equal(t, "cluster-2", searches[1].CriteriaList[0].ExpVal)
+		assert.Equal(t, "PhysicalMachine", searches[3].ClassName)
+		assert.Equal(t, "pmsByClusterName", searches[3].CriteriaList[0].FilterType)
+	}
+}
+
+func TestSearchBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *SearchBuilder
+		err     string
+	}{
+		{"no entity type", NewSearch().NameEquals("x"), "entity type is required"},
+		{"unsupported criterion", NewSearch(EntityTypeStorage).InCluster("cluster-1"),
+			"cluster criterion not supported for entity type Storage"},
+		{"unknown entity type", NewSearch(EntityType("Mainframe")).NameEquals("x"),
+			"name criterion not supported for entity type Mainframe"},
+		{"missing tag key", NewSearch(EntityTypeVM).WithTag("", "db"), "tag key is required"},
+		{"missing tag values", NewSearch(EntityTypeVM).WithTag("team"), "values of tag team are required"},
+		{"regex on numbers", NewSearch(EntityTypeVM).Compare("vmsByNumCPUs", EXP_RXEQ, 4),
+			"expression type RXEQ does not compare numbers"},
+		{"unknown expression", NewSearch(EntityTypeVM).Where("vmsByState", ExpType("LIKE"), "ACTIVE"),
+			"expression type LIKE not supported"},
+		{"dangling negation", NewSearch(EntityTypeVM).NameEquals("x").Not(), "negation is not followed by a criterion"},
+		{"operator changed after criteria", NewSearch(EntityTypeVM).NameEquals("x").Or().NameEquals("y"),
+			"OR must be chosen before adding criteria combined with AND"},
+		{"alternative with entity types", NewSearch(EntityTypeVM).Or(NewSearch(EntityTypeHost).NameEquals("x")),
+			"alternative searches only add criteria"},
+		{"invalid alternative", NewSearch(EntityTypeVM).Or(NewSearch().WithTag("")), "tag key is required"},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.builder.BuildAll()
+		assert.EqualError(t, err, tt.err, tt.name)
+	}
+}
+
+func TestSearchDTOScopes(t *testing.T) {
+	body, err := json.Marshal(SearchDTO{CriteriaList: []Criteria{}, LogicalOperator: "AND", ClassName: "VirtualMachine", Scope: "null"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"criteriaList":[],"logicalOperator":"AND","className":"VirtualMachine","scope":"null"}`, string(body))
+
+	body, err = json.Marshal(SearchDTO{CriteriaList: []Criteria{}, LogicalOperator: "AND", ClassName: "VirtualMachine",
+		Scope: "123", Scopes: []string{"456", "789"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"criteriaList":[],"logicalOperator":"AND","className":"VirtualMachine","scope":["123","456","789"]}`, string(body))
+}
+
+func TestSearchEntitiesWithBuilder(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/criteria" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/search", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"criteriaList":[{"caseSensitive":false,"expType":"RXEQ","expVal":"prod-.*","filterType":"vmsByName"}],`+
+			`"logicalOperator":"AND","className":"VirtualMachine","environmentType":"CLOUD","scope":["123","456"]}`+"\n",
+			string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"uuid":"1","displayName":"prod-1","className":"VirtualMachine"}]`)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	search, err := NewSearch(EntityTypeVM).NameMatches("prod-.*").InScope("123", "456").EnvironmentType(CLOUD).Build()
+	assert.NoError(t, err)
+
+	results, err := client.SearchEntities(search, CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, results.Uuids())
+}
+
+func TestSearchWithAlternatives(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveSearchCriteria(t, w, r) {
+			return
+		}
+		assert.Equal(t, "/search", r.URL.Path)
+		var search SearchDTO
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &search))
+
+		// Each alternative is sent as its own search, the second one having
+		// two pages
+		var response string
+		switch search.CriteriaList[0].ExpVal {
+		case "cluster-1":
+			response = `[{"uuid":"1","displayName":"prod-1"},{"uuid":"2","displayName":"prod-2"}]`
+		case "cluster-2":
+			if r.URL.Query().Get("cursor") == "" {
+				w.Header().Set("X-Next-Cursor", "1")
+				response = `[{"uuid":"2","displayName":"prod-2"}]`
+			} else {
+				response = `[{"uuid":"3","displayName":"prod-3"}]`
+			}
+		default:
+			t.Errorf("unexpected search %s", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	results, err := client.Search(NewSearch(EntityTypeVM).InCluster("cluster-1").Or(NewSearch().InCluster("cluster-2")), CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, results.Uuids())
+
+	_, err = client.Search(NewSearch(EntityTypeVM).Not(), CommonReqParams{})
+	assert.EqualError(t, err, "negation is not followed by a criterion")
+}
+
+func TestSearchDiscoveredNameFilter(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/search/criteria":
+			// GPUs are unknown to this library, their name filter is discovered
+			response = `[{"filterType":"gpusByName","entityType":"GPU","elements":"displayName"},
+				{"filterType":"gpusByTag","entityType":"GPU"}]`
+		case "/search":
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, `{"criteriaList":[{"caseSensitive":false,"expType":"EQ","expVal":"gpu-1","filterType":"gpusByName"}],`+
+				`"logicalOperator":"AND","className":"GPU"}`+"\n", string(body))
+			response = `[{"uuid":"1","displayName":"gpu-1","className":"GPU"}]`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	search := NewSearch(EntityType("GPU")).NameEquals("gpu-1")
+
+	// Without discovery only the filters known to this library are available
+	_, err := search.Build()
+	assert.EqualError(t, err, "name criterion not supported for entity type GPU")
+
+	results, err := client.Search(search, CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, results.Uuids())
+
+	_, err = client.Search(NewSearch(EntityType("Mainframe")).NameEquals("x"), CommonReqParams{})
+	assert.EqualError(t, err, "name criterion not supported for entity type Mainframe")
+}
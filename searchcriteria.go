@@ -0,0 +1,219 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Element of an entity matched by its name filter
+const displayNameElement = "displayName"
+
+// Time during which a failed discovery is not retried and searches fall back
+// on the filters known to this library
+var searchCriteriaRetryInterval = 5 * time.Minute
+
+// Expression types accepted by the criteria of a search
+var searchExpTypes = []ExpType{EXP_EQ, EXP_NEQ, EXP_RXEQ, EXP_RXNEQ, EXP_GT, EXP_GTE, EXP_LT, EXP_LTE}
+
+// Filter available to the criteria of a search, as described by Turbonomic's API
+type SearchCriterion struct {
+	FilterType     string `json:"filterType"`
+	EntityType     string `json:"entityType"`
+	FilterCategory string `json:"filterCategory,omitempty"`
+	Elements       string `json:"elements,omitempty"`
+	InputType      string `json:"inputType,omitempty"`
+	// The values of the filter are limited to the options of the filter type
+	LoadOptions bool `json:"loadOptions,omitempty"`
+}
+
+// Value allowed for a filter type
+type CriteriaOption struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// Search criteria discovered from the server, cached for the life of the client
+type searchCriteriaCache struct {
+	mu       sync.Mutex
+	criteria []SearchCriterion
+	options  map[string][]CriteriaOption
+	// Error of the last failed discovery, returned until retryAt
+	err     error
+	retryAt time.Time
+}
+
+// Retrives the filters available to search criteria. They are only
+// retrived from the server once. A failure is returned again without
+// contacting the server until discovery is retried a few minutes later.
+func (c *Client) GetSearchCriteria(reqParams CommonReqParams) ([]SearchCriterion, error) {
+
+	c.searchCriteria.mu.Lock()
+	criteria, err := c.searchCriteria.criteria, c.searchCriteria.err
+	retry := time.Now().After(c.searchCriteria.retryAt)
+	c.searchCriteria.mu.Unlock()
+	if criteria != nil {
+		return criteria, nil
+	}
+	if err != nil && !retry {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/search/criteria", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, c.searchCriteriaFailed(err)
+	}
+
+	criteria = []SearchCriterion{}
+	if err := json.Unmarshal(restResp, &criteria); err != nil {
+		return nil, c.searchCriteriaFailed(err)
+	}
+
+	c.searchCriteria.mu.Lock()
+	c.searchCriteria.criteria = criteria
+	c.searchCriteria.err = nil
+	c.searchCriteria.mu.Unlock()
+
+	return criteria, nil
+}
+
+// Caches the failure of discovery until it is retried, logging it once
+func (c *Client) searchCriteriaFailed(err error) error {
+	err = fmt.Errorf("discovering search criteria: %w", err)
+
+	retryAt := time.Now().Add(searchCriteriaRetryInterval)
+
+	c.searchCriteria.mu.Lock()
+	c.searchCriteria.err = err
+	c.searchCriteria.retryAt = retryAt
+	c.searchCriteria.mu.Unlock()
+
+	if c.Logger != nil {
+		c.Logger.Debug(c.Ctx, "search criteria unavailable, using the filters known to this library until "+
+			retryAt.Format(time.RFC3339), "error", err.Error())
+	}
+	return err
+}
+
+// Retrives the values allowed for a filter type. They are only retrived from
+// the server once per filter type.
+func (c *Client) GetSearchCriteriaOptions(filterType string, reqParams CommonReqParams) ([]CriteriaOption, error) {
+
+	c.searchCriteria.mu.Lock()
+	options, ok := c.searchCriteria.options[filterType]
+	c.searchCriteria.mu.Unlock()
+	if ok {
+		return options, nil
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/search/criteria/" + url.PathEscape(filterType) + "/options",
+		ReqDTO: new(bytes.Buffer), CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	options = []CriteriaOption{}
+	if err := json.Unmarshal(restResp, &options); err != nil {
+		return nil, err
+	}
+
+	c.searchCriteria.mu.Lock()
+	if c.searchCriteria.options == nil {
+		c.searchCriteria.options = map[string][]CriteriaOption{}
+	}
+	c.searchCriteria.options[filterType] = options
+	c.searchCriteria.mu.Unlock()
+
+	return options, nil
+}
+
+// Checks the entity type and criteria of a search against the discovered
+// search criteria. Searches are not validated when discovery is unavailable.
+func (c *Client) validateSearch(searchCriteria SearchDTO, reqParams CommonReqParams) error {
+
+	criteria, err := c.GetSearchCriteria(reqParams)
+	if err != nil {
+		return nil
+	}
+
+	filters := map[string]SearchCriterion{}
+	entityTypes := map[string]bool{}
+	for _, criterion := range criteria {
+		filters[criterion.FilterType] = criterion
+		entityTypes[criterion.EntityType] = true
+	}
+
+	if searchCriteria.ClassName != "" && !entityTypes[searchCriteria.ClassName] {
+		return fmt.Errorf("entity type of %s not supported", searchCriteria.ClassName)
+	}
+
+	for _, criterion := range searchCriteria.CriteriaList {
+		if !slices.Contains(searchExpTypes, ExpType(criterion.ExpType)) {
+			return fmt.Errorf("expression type %s of filter %s not supported", criterion.ExpType, criterion.FilterType)
+		}
+
+		filter, ok := filters[criterion.FilterType]
+		if !ok {
+			return fmt.Errorf("filter type %s not supported", criterion.FilterType)
+		}
+		if searchCriteria.ClassName != "" && filter.EntityType != searchCriteria.ClassName {
+			return fmt.Errorf("filter type %s does not apply to entity type %s", criterion.FilterType, searchCriteria.ClassName)
+		}
+
+		if !filter.LoadOptions || (ExpType(criterion.ExpType) != EXP_EQ && ExpType(criterion.ExpType) != EXP_NEQ) {
+			continue
+		}
+		options, err := c.GetSearchCriteriaOptions(criterion.FilterType, reqParams)
+		if err != nil {
+			continue
+		}
+		for _, value := range strings.Split(criterion.ExpVal, "|") {
+			if !slices.ContainsFunc(options, func(o CriteriaOption) bool { return o.Value == value }) {
+				return fmt.Errorf("value %s of filter %s not supported", value, criterion.FilterType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns the name filter of an entity type from the discovered search
+// criteria, falling back on the filters known to this library when
+// discovery is unavailable or does not describe the entity type
+func (c *Client) getFilterType(entityType string, reqParams CommonReqParams) (string, error) {
+
+	if criteria, err := c.GetSearchCriteria(reqParams); err == nil {
+		for _, criterion := range criteria {
+			if criterion.EntityType == entityType && criterion.Elements == displayNameElement {
+				return criterion.FilterType, nil
+			}
+		}
+	}
+
+	filterType := entityNameMap[entityType]
+	if filterType != "" {
+		return filterType, nil
+	}
+	return "", fmt.Errorf("entity type of %s not supported", entityType)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Automation mode of an action type in a settings policy
+type ActionMode string
+
+const (
+	RECOMMEND         ActionMode = "RECOMMEND"
+	MANUAL            ActionMode = "MANUAL"
+	AUTOMATIC         ActionMode = "AUTOMATIC"
+	DISABLED          ActionMode = "DISABLED"
+	EXTERNAL_APPROVAL ActionMode = "EXTERNAL_APPROVAL"
+)
+
+// Uuid of the settings manager holding action automation settings
+const AutomationManager = "automationmanager"
+
+// Parameters for listing settings policies from Turbonomic's API
+type SettingsPoliciesRequest struct {
+	OnlyDefaults    bool
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving a settings policy from Turbonomic's API
+type SettingsPolicyRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a settings policy using Turbonomic's API
+type SettingsPolicyInputRequest struct {
+	Uuid            string
+	Policy          SettingsPolicyInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API settings policies
+type SettingsPolicyInput struct {
+	DisplayName      string            `json:"displayName"`
+	EntityType       string            `json:"entityType"`
+	Scopes           []BaseApiDTO      `json:"scopes,omitempty"`
+	Schedule         *BaseApiDTO       `json:"schedule,omitempty"`
+	SettingsManagers []SettingsManager `json:"settingsManagers"`
+	Disabled         bool              `json:"disabled"`
+}
+
+// Settings policy returned by Turbonomic's API
+type SettingsPolicy struct {
+	UUID             string            `json:"uuid"`
+	DisplayName      string            `json:"displayName"`
+	EntityType       string            `json:"entityType"`
+	Scopes           []Group           `json:"scopes,omitempty"`
+	Schedule         *Schedule         `json:"schedule,omitempty"`
+	SettingsManagers []SettingsManager `json:"settingsManagers"`
+	Disabled         bool              `json:"disabled"`
+	Default          bool              `json:"default"`
+	ReadOnly         bool              `json:"readOnly"`
+}
+
+// Group of related settings within a settings policy
+type SettingsManager struct {
+	UUID        string    `json:"uuid"`
+	DisplayName string    `json:"displayName,omitempty"`
+	Category    string    `json:"category,omitempty"`
+	Settings    []Setting `json:"settings"`
+}
+
+// Single setting and its value within a settings manager
+type Setting struct {
+	UUID         string          `json:"uuid"`
+	DisplayName  string          `json:"displayName,omitempty"`
+	Value        string          `json:"value"`
+	DefaultValue string          `json:"defaultValue,omitempty"`
+	ValueType    string          `json:"valueType,omitempty"`
+	EntityType   string          `json:"entityType,omitempty"`
+	Options      []SettingOption `json:"options,omitempty"`
+	Min          *float64        `json:"min,omitempty"`
+	Max          *float64        `json:"max,omitempty"`
+	// Policy whose value applies to an entity, set when retriving the
+	// settings of an entity
+	ActiveSettingsPolicy *BaseApiDTO `json:"activeSettingsPolicy,omitempty"`
+	SourceGroupName      string      `json:"sourceGroupName,omitempty"`
+	SourceGroupUuid      string      `json:"sourceGroupUuid,omitempty"`
+}
+
+// Allowed value of an enumerated setting
+type SettingOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// Sets the value of a setting, adding the setting and its manager to the policy
+// input when they are not present yet
+func (p *SettingsPolicyInput) SetSetting(managerUuid, settingUuid, value string) {
+	for i := range p.SettingsManagers {
+		manager := &p.SettingsManagers[i]
+		if manager.UUID != managerUuid {
+			continue
+		}
+		for j := range manager.Settings {
+			if manager.Settings[j].UUID == settingUuid {
+				manager.Settings[j].Value = value
+				return
+			}
+		}
+		manager.Settings = append(manager.Settings, Setting{UUID: settingUuid, Value: value})
+		return
+	}
+	p.SettingsManagers = append(p.SettingsManagers, SettingsManager{
+		UUID:     managerUuid,
+		Settings: []Setting{{UUID: settingUuid, Value: value}},
+	})
+}
+
+// Sets the automation mode of an action type, such as "resize" or "move"
+func (p *SettingsPolicyInput) SetActionMode(actionSetting string, mode ActionMode) {
+	p.SetSetting(AutomationManager, actionSetting, string(mode))
+}
+
+// Returns the setting with the provided uuid from the given manager
+func (p SettingsPolicy) Setting(managerUuid, settingUuid string) (*Setting, bool) {
+	for _, manager := range p.SettingsManagers {
+		if manager.UUID != managerUuid {
+			continue
+		}
+		for _, setting := range manager.Settings {
+			if setting.UUID == settingUuid {
+				return &setting, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Builds the input required to update the settings policy with its current
+// values. Settings are copied with only their uuid and value, so changing the
+// input leaves the policy untouched and read-only fields are not sent back.
+func (p SettingsPolicy) Input() SettingsPolicyInput {
+	input := SettingsPolicyInput{
+		DisplayName:      p.DisplayName,
+		EntityType:       p.EntityType,
+		SettingsManagers: make([]SettingsManager, 0, len(p.SettingsManagers)),
+		Disabled:         p.Disabled,
+	}
+	for _, manager := range p.SettingsManagers {
+		settings := make([]Setting, 0, len(manager.Settings))
+		for _, setting := range manager.Settings {
+			settings = append(settings, Setting{UUID: setting.UUID, Value: setting.Value})
+		}
+		input.SettingsManagers = append(input.SettingsManagers, SettingsManager{UUID: manager.UUID, Settings: settings})
+	}
+	for _, scope := range p.Scopes {
+		input.Scopes = append(input.Scopes, BaseApiDTO{UUID: scope.UUID})
+	}
+	if p.Schedule != nil {
+		input.Schedule = &BaseApiDTO{UUID: p.Schedule.UUID}
+	}
+	return input
+}
+
+// Retrives settings policies, optionally restricted to the default policies
+func (c *Client) GetSettingsPolicies(policiesReq SettingsPoliciesRequest) ([]SettingsPolicy, error) {
+
+	reqParams := policiesReq.CommonReqParams
+	if policiesReq.OnlyDefaults {
+		reqParams = withQueryParameters(reqParams, map[string]string{
+			"onlyDefaults": strconv.FormatBool(policiesReq.OnlyDefaults)})
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/settingspolicies", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []SettingsPolicy
+	if err := json.Unmarshal(restResp, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Retrives the default settings policy of each entity type
+func (c *Client) GetDefaultSettingsPolicies(reqParams CommonReqParams) ([]SettingsPolicy, error) {
+	return c.GetSettingsPolicies(SettingsPoliciesRequest{OnlyDefaults: true, CommonReqParams: reqParams})
+}
+
+// Retrives a settings policy based on its provided uuid
+func (c *Client) GetSettingsPolicy(policyReq SettingsPolicyRequest) (*SettingsPolicy, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/settingspolicies/" + policyReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: policyReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var policy SettingsPolicy
+	if err := json.Unmarshal(restResp, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Creates a settings policy
+func (c *Client) CreateSettingsPolicy(policyReq SettingsPolicyInputRequest) (*SettingsPolicy, error) {
+	return c.sendSettingsPolicy("POST", "/settingspolicies", policyReq)
+}
+
+// Updates the settings policy with the provided uuid
+func (c *Client) UpdateSettingsPolicy(policyReq SettingsPolicyInputRequest) (*SettingsPolicy, error) {
+	return c.sendSettingsPolicy("PUT", "/settingspolicies/"+policyReq.Uuid, policyReq)
+}
+
+// Deletes the settings policy with the provided uuid
+func (c *Client) DeleteSettingsPolicy(policyReq SettingsPolicyRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/settingspolicies/" + policyReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: policyReq.CommonReqParams})
+
+	return err
+}
+
+func (c *Client) sendSettingsPolicy(method, urlPath string, policyReq SettingsPolicyInputRequest) (*SettingsPolicy, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(policyReq.Policy); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: policyReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var policy SettingsPolicy
+	if err := json.Unmarshal(restResp, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
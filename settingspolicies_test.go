@@ -0,0 +1,183 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSettingsPolicy(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetSettingsPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	// Create a test server with the mock response
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/settingspolicies/285024589026640", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Errorf("failed to write header: %s", err.Error())
+			t.FailNow()
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	policy, err := client.GetSettingsPolicy(SettingsPolicyRequest{Uuid: "285024589026640"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AppInfra Automation", policy.DisplayName)
+	assert.Equal(t, "285024588762240", policy.Scopes[0].UUID)
+	assert.Equal(t, "Weekend Maintenance", policy.Schedule.DisplayName)
+
+	resize, ok := policy.Setting(AutomationManager, "resize")
+	assert.True(t, ok)
+	assert.Equal(t, string(AUTOMATIC), resize.Value)
+
+	utilTarget, ok := policy.Setting("capacityControlManager", "utilTarget")
+	assert.True(t, ok)
+	assert.Equal(t, 100.0, *utilTarget.Max)
+
+	_, ok = policy.Setting(AutomationManager, "provision")
+	assert.False(t, ok)
+
+	// The input is a copy holding only the uuid and value of each setting
+	input := policy.Input()
+	input.SetActionMode("resize", MANUAL)
+	resize, _ = policy.Setting(AutomationManager, "resize")
+	assert.Equal(t, string(AUTOMATIC), resize.Value)
+	assert.Equal(t, []SettingsManager{
+		{UUID: AutomationManager, Settings: []Setting{{UUID: "resize", Value: string(MANUAL)}, {UUID: "move", Value: "RECOMMEND"}}},
+		{UUID: "capacityControlManager", Settings: []Setting{{UUID: "utilTarget", Value: "70"}}},
+	}, input.SettingsManagers)
+}
+
+func TestGetDefaultSettingsPolicies(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/settingspolicies", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("onlyDefaults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"uuid":"1","displayName":"Virtual Machine Defaults",
+			"entityType":"VirtualMachine","default":true,"settingsManagers":[]}]`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	policies, err := client.GetDefaultSettingsPolicies(CommonReqParams{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policies))
+	assert.True(t, policies[0].Default)
+}
+
+func TestCreateSettingsPolicy(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	mockResponse, err := os.ReadFile("./testfiles/GetSettingsPolicy.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/settingspolicies", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"displayName\":\"AppInfra Automation\",\"entityType\":\"VirtualMachine\","+
+			"\"scopes\":[{\"uuid\":\"285024588762240\"}],"+
+			"\"settingsManagers\":[{\"uuid\":\"automationmanager\",\"settings\":["+
+			"{\"uuid\":\"resize\",\"value\":\"AUTOMATIC\"},{\"uuid\":\"move\",\"value\":\"RECOMMEND\"}]}],"+
+			"\"disabled\":false}\n",
+			string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	input := SettingsPolicyInput{
+		DisplayName: "AppInfra Automation",
+		EntityType:  "VirtualMachine",
+		Scopes:      []BaseApiDTO{{UUID: "285024588762240"}},
+	}
+	input.SetActionMode("resize", MANUAL)
+	input.SetActionMode("move", RECOMMEND)
+	input.SetActionMode("resize", AUTOMATIC)
+
+	policy, err := client.CreateSettingsPolicy(SettingsPolicyInputRequest{Policy: input})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "285024589026640", policy.UUID)
+}
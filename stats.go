@@ -17,15 +17,45 @@ package turboclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // StatsRequest represents the parameters for retrieving statistics from Turbonomic's API
 type StatsRequest struct {
-	EntityUUID      string
-	StartDate       string
-	EndDate         string
+	EntityUUID string
+	StartDate  string
+	EndDate    string
+	// Range of dates, used for the dates which are not set explicitly
+	Range           TimeRange
+	Statistics      []StatisticRequest
+	CommonReqParams CommonReqParams
+}
+
+// TimeRange represents the range of dates of a statistics request, either
+// absolute or relative to the time of the request
+type TimeRange struct {
+	start       time.Time
+	end         time.Time
+	startOffset time.Duration
+	endOffset   time.Duration
+}
+
+// ScopedStatsRequest represents the parameters for retrieving statistics of
+// several scopes, such as entities or groups, in a single request
+type ScopedStatsRequest struct {
+	ScopeUuids []string
+	// Retrieves the statistics of the entities of this type in the scopes,
+	// such as VirtualMachine, rather than of the scopes themselves
+	RelatedType string
+	StartDate   string
+	EndDate     string
+	// Range of dates, used for the dates which are not set explicitly
+	Range           TimeRange
 	Statistics      []StatisticRequest
 	CommonReqParams CommonReqParams
 }
@@ -35,6 +65,7 @@ type StatisticRequest struct {
 	Name              string   `json:"name"`
 	RelatedEntityType string   `json:"relatedEntityType,omitempty"`
 	Filters           []Filter `json:"filters,omitempty"`
+	GroupBy           []string `json:"groupBy,omitempty"`
 }
 
 // Filter represents a filter to be applied to the statistics
@@ -51,6 +82,26 @@ type StatsRequestBody struct {
 	Statistics []StatisticRequest `json:"statistics"`
 }
 
+// StatScopesRequestBody represents the request body for the statistics of several scopes
+type StatScopesRequestBody struct {
+	Scopes      []string         `json:"scopes"`
+	RelatedType string           `json:"relatedType,omitempty"`
+	Period      StatsRequestBody `json:"period"`
+}
+
+// ScopedStatsResponse represents the statistics of several scopes, one
+// element for each scope or related entity
+type ScopedStatsResponse []ScopedStats
+
+// ScopedStats represents the statistics of a single scope or related entity
+type ScopedStats struct {
+	UUID            string        `json:"uuid"`
+	DisplayName     string        `json:"displayName"`
+	ClassName       string        `json:"className"`
+	EnvironmentType string        `json:"environmentType,omitempty"`
+	Stats           StatsResponse `json:"stats"`
+}
+
 // StatsResponse represents the response from the statistics API
 type StatsResponse []EntityStats
 
@@ -100,26 +151,216 @@ type HistUtilization struct {
 
 // GetStats retrieves statistics from Turbonomic's API based on request parameters
 func (c *Client) GetStats(statsReq StatsRequest) (StatsResponse, error) {
+	startDate, endDate := statsReq.Range.dates(statsReq.StartDate, statsReq.EndDate)
 	requestBody := StatsRequestBody{
-		StartDate:  statsReq.StartDate,
-		EndDate:    statsReq.EndDate,
+		StartDate:  startDate,
+		EndDate:    endDate,
 		Statistics: statsReq.Statistics,
 	}
 
+	urlPath := "/stats/" + statsReq.EntityUUID
+
+	return c.postStats(urlPath, requestBody, statsReq.CommonReqParams)
+}
+
+// GetScopedStats retrieves the statistics of several scopes, keeping the
+// statistics of each scope or related entity apart. All pages of results
+// are retrieved.
+func (c *Client) GetScopedStats(statsReq ScopedStatsRequest) (ScopedStatsResponse, error) {
+	startDate, endDate := statsReq.Range.dates(statsReq.StartDate, statsReq.EndDate)
+	requestBody := StatScopesRequestBody{
+		Scopes:      statsReq.ScopeUuids,
+		RelatedType: statsReq.RelatedType,
+		Period: StatsRequestBody{
+			StartDate:  startDate,
+			EndDate:    endDate,
+			Statistics: statsReq.Statistics,
+		},
+	}
+
+	scopedStats := ScopedStatsResponse{}
+	cursors := map[string]bool{}
+	cursor := ""
+	for {
+		dtoBuf := new(bytes.Buffer)
+		if err := json.NewEncoder(dtoBuf).Encode(requestBody); err != nil {
+			return nil, err
+		}
+
+		restResp, headers, err := c.requestWithHeaders(context.Background(), RequestOptions{Method: "POST", Path: "/stats",
+			ReqDTO: dtoBuf, CommonReqParams: withCursor(statsReq.CommonReqParams, cursor)})
+		if err != nil {
+			return nil, err
+		}
+
+		var page ScopedStatsResponse
+		if err := json.Unmarshal(restResp, &page); err != nil {
+			return nil, err
+		}
+		scopedStats = append(scopedStats, page...)
+
+		// A cursor seen before would page through the same scopes again
+		cursor = headers.Get("X-Next-Cursor")
+		if cursor == "" || cursors[cursor] {
+			return scopedStats, nil
+		}
+		cursors[cursor] = true
+	}
+}
+
+// Between returns the range of dates from start to end
+func Between(start, end time.Time) TimeRange {
+	return TimeRange{start: start, end: end}
+}
+
+// Last returns the range of dates of the past duration, such as the last 7 days
+func Last(duration time.Duration) TimeRange {
+	return TimeRange{startOffset: -duration}
+}
+
+// Next returns the range of dates of the coming duration, such as the next
+// 30 days, for which statistics are projected
+func Next(duration time.Duration) TimeRange {
+	return TimeRange{endOffset: duration}
+}
+
+// Returns the dates of the range as accepted by the stats API, keeping the
+// dates which are set explicitly
+func (r TimeRange) dates(startDate, endDate string) (string, string) {
+	if startDate == "" {
+		startDate = formatStatsDate(r.start, r.startOffset)
+	}
+	if endDate == "" {
+		endDate = formatStatsDate(r.end, r.endOffset)
+	}
+	return startDate, endDate
+}
+
+// Formats a date as epoch milliseconds, or an offset from the time of the
+// request such as -7d, +12h or +10m
+func formatStatsDate(date time.Time, offset time.Duration) string {
+	switch {
+	case !date.IsZero():
+		return strconv.FormatInt(date.UnixMilli(), 10)
+	case offset == 0:
+		return ""
+	}
+
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	switch {
+	case offset%(24*time.Hour) == 0:
+		return fmt.Sprintf("%s%dd", sign, offset/(24*time.Hour))
+	case offset%time.Hour == 0:
+		return fmt.Sprintf("%s%dh", sign, offset/time.Hour)
+	case offset%time.Minute == 0:
+		return fmt.Sprintf("%s%dm", sign, offset/time.Minute)
+	}
+	// Offsets finer than a minute cannot be expressed relatively
+	if sign == "-" {
+		offset = -offset
+	}
+	return strconv.FormatInt(time.Now().Add(offset).UnixMilli(), 10)
+}
+
+// Decodes the date of the statistics as an RFC 3339 date, a date without
+// time zone, which is taken as UTC, or epoch milliseconds. Dates are
+// returned in UTC.
+func (s *EntityStats) UnmarshalJSON(data []byte) error {
+	type entityStats EntityStats
+	stats := struct {
+		*entityStats
+		Date json.RawMessage `json:"date"`
+	}{entityStats: (*entityStats)(s)}
+
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return err
+	}
+
+	date, err := parseStatsDate(stats.Date)
+	if err != nil {
+		return err
+	}
+	s.Date = date
+	return nil
+}
+
+func parseStatsDate(raw json.RawMessage) (time.Time, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return time.Time{}, nil
+	}
+
+	value := string(raw)
+	if strings.HasPrefix(value, "\"") {
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(millis).UTC(), nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999Z0700"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.UTC(), nil
+		}
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05", "2006-01-02"} {
+		if date, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid statistics date %s", value)
+}
+
+// Returns the statistics of the scope or related entity with the provided uuid
+func (r ScopedStatsResponse) Scope(uuid string) *ScopedStats {
+	for i := range r {
+		if r[i].UUID == uuid {
+			return &r[i]
+		}
+	}
+	return nil
+}
+
+// Returns the snapshots of the statistics recorded in the past
+func (r StatsResponse) Historical() StatsResponse {
+	return r.byEpoch(false)
+}
+
+// Returns the snapshots of the statistics projected after actions
+func (r StatsResponse) Projected() StatsResponse {
+	return r.byEpoch(true)
+}
+
+func (r StatsResponse) byEpoch(projected bool) StatsResponse {
+	snapshots := StatsResponse{}
+	for _, snapshot := range r {
+		if (snapshot.Epoch == ProjectedEpoch) == projected {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots
+}
+
+// Posts a statistics request body to the provided stats endpoint
+func (c *Client) postStats(urlPath string, requestBody StatsRequestBody, reqParams CommonReqParams) (StatsResponse, error) {
+
 	dtoBuf := new(bytes.Buffer)
 	if err := json.NewEncoder(dtoBuf).Encode(requestBody); err != nil {
 		return nil, err
 	}
 
-	urlPath := "/stats/" + statsReq.EntityUUID
 	reqDTO := RequestOptions{
-		Method: "POST",
-		Path:   urlPath,
-		ReqDTO: dtoBuf,
-		CommonReqParams: CommonReqParams{
-			Headers:         statsReq.CommonReqParams.Headers,
-			QueryParameters: statsReq.CommonReqParams.QueryParameters,
-		},
+		Method:          "POST",
+		Path:            urlPath,
+		ReqDTO:          dtoBuf,
+		CommonReqParams: reqParams,
 	}
 
 	restResp, err := c.request(reqDTO)
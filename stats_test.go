@@ -20,11 +20,14 @@ package turboclient
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -117,6 +120,141 @@ func TestClient_GetStats(t *testing.T) {
 	assert.Equal(t, "StorageAccess", statsResp[0].Statistics[0].Name)
 }
 
+func TestClient_GetScopedStats(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	requests := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/stats", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"scopes":["123","456"],"relatedType":"VirtualMachine","period":{"startDate":"-7d","endDate":"+1d",`+
+			`"statistics":[{"name":"VCPU","groupBy":["tag"]}]}}`+"\n", string(body))
+
+		var response string
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("X-Next-Cursor", "1")
+			response = `[{"uuid":"1","displayName":"vm-1","className":"VirtualMachine","stats":[
+				{"date":"2025-01-01T00:00:00Z","epoch":"HISTORICAL","statistics":[{"name":"VCPU","value":40}]},
+				{"date":"2025-01-09T00:00:00Z","epoch":"PROJECTED","statistics":[{"name":"VCPU","value":20}]}]}]`
+		case "1":
+			// The cursor is repeated on the last page
+			w.Header().Set("X-Next-Cursor", "1")
+			response = `[{"uuid":"2","displayName":"vm-2","className":"VirtualMachine","stats":[
+				{"date":"2025-01-01T00:00:00Z","epoch":"HISTORICAL","statistics":[{"name":"VCPU","value":10}]}]}]`
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	scopedStats, err := client.GetScopedStats(ScopedStatsRequest{
+		ScopeUuids:  []string{"123", "456"},
+		RelatedType: "VirtualMachine",
+		StartDate:   "-7d",
+		EndDate:     "+1d",
+		Statistics:  []StatisticRequest{{Name: "VCPU", GroupBy: []string{"tag"}}},
+		// A cursor of the caller does not replace the cursor of each page
+		CommonReqParams: CommonReqParams{QueryParameters: map[string]string{"cursor": "1"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, len(scopedStats))
+	vm := scopedStats.Scope("1")
+	if assert.NotNil(t, vm) {
+		assert.Equal(t, "vm-1", vm.DisplayName)
+		assert.Equal(t, 1, len(vm.Stats.Historical()))
+		if assert.Equal(t, 1, len(vm.Stats.Projected())) {
+			assert.Equal(t, 20.0, vm.Stats.Projected()[0].Statistics[0].Value)
+		}
+	}
+	assert.Equal(t, 10.0, scopedStats.Scope("2").Stats[0].Statistics[0].Value)
+	assert.Nil(t, scopedStats.Scope("3"))
+}
+
+func TestTimeRange(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 8, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name      string
+		timeRange TimeRange
+		startDate string
+		endDate   string
+		wantStart string
+		wantEnd   string
+	}{
+		{"absolute", Between(start, end), "", "", "1735689600000", "1736294400000"},
+		{"last days", Last(7 * 24 * time.Hour), "", "", "-7d", ""},
+		{"next days", Next(30 * 24 * time.Hour), "", "", "", "+30d"},
+		{"hours", Next(12 * time.Hour), "", "", "", "+12h"},
+		{"minutes", Last(90 * time.Minute), "", "", "-90m", ""},
+		{"explicit dates", Last(7 * 24 * time.Hour), "2025-01-01", "+10m", "2025-01-01", "+10m"},
+		{"explicit end date", Last(24 * time.Hour), "", "+1d", "-1d", "+1d"},
+		{"unset", TimeRange{}, "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		startDate, endDate := tt.timeRange.dates(tt.startDate, tt.endDate)
+		assert.Equal(t, tt.wantStart, startDate, tt.name)
+		assert.Equal(t, tt.wantEnd, endDate, tt.name)
+	}
+
+	// Offsets finer than a minute are sent as absolute dates
+	before := time.Now().Add(-30 * time.Second).UnixMilli()
+	startDate, _ := Last(30*time.Second).dates("", "")
+	millis, err := strconv.ParseInt(startDate, 10, 64)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, millis, before)
+	assert.LessOrEqual(t, millis, time.Now().UnixMilli())
+}
+
+func TestEntityStatsDate(t *testing.T) {
+	want := time.Date(2025, 8, 5, 10, 7, 49, 0, time.UTC)
+
+	for _, date := range []string{
+		`"2025-08-05T10:07:49Z"`,
+		`"2025-08-05T12:07:49+02:00"`,
+		`"2025-08-05T12:07:49+0200"`,
+		`"2025-08-05T10:07:49"`,
+		`"2025-08-05 10:07:49"`,
+		`1754388469000`,
+		`"1754388469000"`,
+	} {
+		var stats EntityStats
+		err := json.Unmarshal([]byte(`{"displayName":"vm-1","date":`+date+`,"epoch":"HISTORICAL","statistics":[{"name":"VCPU"}]}`), &stats)
+		assert.NoError(t, err, date)
+		assert.Equal(t, want, stats.Date, date)
+		assert.Equal(t, time.UTC, stats.Date.Location(), date)
+		assert.Equal(t, "vm-1", stats.DisplayName, date)
+		assert.Equal(t, "HISTORICAL", stats.Epoch, date)
+		assert.Equal(t, 1, len(stats.Statistics), date)
+	}
+
+	var stats EntityStats
+	assert.NoError(t, json.Unmarshal([]byte(`{"displayName":"vm-1"}`), &stats))
+	assert.True(t, stats.Date.IsZero())
+	assert.EqualError(t, json.Unmarshal([]byte(`{"date":"yesterday"}`), &stats), "invalid statistics date yesterday")
+}
+
 func TestGetStatsIntegration(t *testing.T) {
 	if os.Getenv("INTEGRATION") == "" {
 		t.Skip("skipping integration tests, to run set environment variable INTEGRATION")
@@ -0,0 +1,207 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Detail level of the entities returned in a supply chain
+const (
+	SupplyChainCompact = "compact"
+	SupplyChainEntity  = "entity"
+)
+
+// Options for retriving a supply chain from Turbonomic's API
+type SupplyChainOptions struct {
+	// Entity types to include, all types when empty
+	EntityTypes     []string
+	EntityStates    []string
+	EnvironmentType string
+	// Set to SupplyChainEntity to include the member entities of each type,
+	// which is required by EntitiesAbove and EntitiesBelow
+	DetailType           string
+	IncludeHealthSummary bool
+	CommonReqParams      CommonReqParams
+}
+
+// Supply chain returned by Turbonomic's API, keyed by entity type
+type SupplyChain struct {
+	SeMap map[string]SupplyChainTier `json:"seMap"`
+}
+
+// Entities of a single type in a supply chain and the types they connect to
+type SupplyChainTier struct {
+	Depth                  int                          `json:"depth"`
+	EntitiesCount          int                          `json:"entitiesCount"`
+	StateSummary           map[string]int               `json:"stateSummary,omitempty"`
+	HealthSummary          map[string]int               `json:"healthSummary,omitempty"`
+	ConnectedProviderTypes []string                     `json:"connectedProviderTypes,omitempty"`
+	ConnectedConsumerTypes []string                     `json:"connectedConsumerTypes,omitempty"`
+	Instances              map[string]SupplyChainMember `json:"instances,omitempty"`
+}
+
+// Entity of a supply chain with its direct providers and consumers
+type SupplyChainMember struct {
+	UUID            string       `json:"uuid"`
+	DisplayName     string       `json:"displayName"`
+	ClassName       string       `json:"className"`
+	State           string       `json:"state,omitempty"`
+	Severity        string       `json:"severity,omitempty"`
+	EnvironmentType string       `json:"environmentType,omitempty"`
+	Providers       []BaseApiDTO `json:"providers,omitempty"`
+	Consumers       []BaseApiDTO `json:"consumers,omitempty"`
+}
+
+// Retrives the supply chain of the provided scopes, the whole environment when
+// no scope is provided
+func (c *Client) GetSupplyChain(ctx context.Context, scopeUUIDs []string, options SupplyChainOptions) (*SupplyChain, error) {
+
+	queryParameters := map[string]string{
+		"uuids":            strings.Join(scopeUUIDs, ","),
+		"types":            strings.Join(options.EntityTypes, ","),
+		"entity_states":    strings.Join(options.EntityStates, ","),
+		"environment_type": options.EnvironmentType,
+		"detail_type":      options.DetailType,
+	}
+	if options.IncludeHealthSummary {
+		queryParameters["health"] = "true"
+	}
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: "GET", Path: "/supplychains", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: withQueryParameters(options.CommonReqParams, queryParameters)})
+	if err != nil {
+		return nil, err
+	}
+
+	var supplyChain SupplyChain
+	if err := json.Unmarshal(restResp, &supplyChain); err != nil {
+		return nil, err
+	}
+
+	return &supplyChain, nil
+}
+
+// Returns the entity types of the supply chain, ordered from top to bottom
+func (s SupplyChain) EntityTypes() []string {
+	entityTypes := make([]string, 0, len(s.SeMap))
+	for entityType := range s.SeMap {
+		entityTypes = append(entityTypes, entityType)
+	}
+	slices.SortFunc(entityTypes, func(a, b string) int {
+		if depthA, depthB := s.SeMap[a].Depth, s.SeMap[b].Depth; depthA != depthB {
+			return depthA - depthB
+		}
+		return strings.Compare(a, b)
+	})
+	return entityTypes
+}
+
+// Returns the entity types which directly or indirectly consume from the
+// provided type, such as the applications running above virtual machines
+func (s SupplyChain) Upstream(entityType string) []string {
+	return s.connectedTypes(entityType, func(tier SupplyChainTier) []string { return tier.ConnectedConsumerTypes })
+}
+
+// Returns the entity types which directly or indirectly provide to the
+// provided type, such as the hosts and storage below virtual machines
+func (s SupplyChain) Downstream(entityType string) []string {
+	return s.connectedTypes(entityType, func(tier SupplyChainTier) []string { return tier.ConnectedProviderTypes })
+}
+
+// Returns a supply chain restricted to the provided entity types
+func (s SupplyChain) FilterByType(entityTypes ...string) SupplyChain {
+	filtered := SupplyChain{SeMap: map[string]SupplyChainTier{}}
+	for _, entityType := range entityTypes {
+		if tier, ok := s.SeMap[entityType]; ok {
+			filtered.SeMap[entityType] = tier
+		}
+	}
+	return filtered
+}
+
+// Returns the entity with the provided uuid
+func (s SupplyChain) Entity(uuid string) (SupplyChainMember, bool) {
+	for _, tier := range s.SeMap {
+		if member, ok := tier.Instances[uuid]; ok {
+			return member, true
+		}
+	}
+	return SupplyChainMember{}, false
+}
+
+// Returns the entities of the provided type which directly or indirectly
+// consume from the entity with the provided uuid, such as all virtual
+// machines on a storage
+func (s SupplyChain) EntitiesAbove(uuid, entityType string) []SupplyChainMember {
+	return s.connectedEntities(uuid, entityType, func(member SupplyChainMember) []BaseApiDTO { return member.Consumers })
+}
+
+// Returns the entities of the provided type which the entity with the provided
+// uuid directly or indirectly consumes from, such as the hosts of an application
+func (s SupplyChain) EntitiesBelow(uuid, entityType string) []SupplyChainMember {
+	return s.connectedEntities(uuid, entityType, func(member SupplyChainMember) []BaseApiDTO { return member.Providers })
+}
+
+func (s SupplyChain) connectedTypes(entityType string, next func(SupplyChainTier) []string) []string {
+	var connected []string
+	visited := map[string]bool{entityType: true}
+	queue := []string{entityType}
+	for len(queue) > 0 {
+		tier, ok := s.SeMap[queue[0]]
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for _, connectedType := range next(tier) {
+			if visited[connectedType] {
+				continue
+			}
+			visited[connectedType] = true
+			connected = append(connected, connectedType)
+			queue = append(queue, connectedType)
+		}
+	}
+	return connected
+}
+
+func (s SupplyChain) connectedEntities(uuid, entityType string, next func(SupplyChainMember) []BaseApiDTO) []SupplyChainMember {
+	var connected []SupplyChainMember
+	visited := map[string]bool{uuid: true}
+	queue := []string{uuid}
+	for len(queue) > 0 {
+		member, ok := s.Entity(queue[0])
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for _, ref := range next(member) {
+			if visited[ref.UUID] {
+				continue
+			}
+			visited[ref.UUID] = true
+			if found, ok := s.Entity(ref.UUID); ok && found.ClassName == entityType {
+				connected = append(connected, found)
+			}
+			queue = append(queue, ref.UUID)
+		}
+	}
+	return connected
+}
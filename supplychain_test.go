@@ -0,0 +1,115 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSupplyChain(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetSupplyChain.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/supplychains", r.URL.Path)
+		assert.Equal(t, "285024588762240,285024588762241", r.URL.Query().Get("uuids"))
+		assert.Equal(t, "entity", r.URL.Query().Get("detail_type"))
+		assert.Equal(t, "true", r.URL.Query().Get("health"))
+		assert.False(t, r.URL.Query().Has("types"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	supplyChain, err := client.GetSupplyChain(context.Background(), []string{"285024588762240", "285024588762241"},
+		SupplyChainOptions{DetailType: SupplyChainEntity, IncludeHealthSummary: true})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Application", "VirtualMachine", "PhysicalMachine", "Storage"}, supplyChain.EntityTypes())
+	vms := supplyChain.SeMap["VirtualMachine"]
+	assert.Equal(t, 3, vms.EntitiesCount)
+	assert.Equal(t, 1, vms.StateSummary["IDLE"])
+	assert.Equal(t, 1, vms.HealthSummary["Critical"])
+
+	assert.Equal(t, []string{"VirtualMachine", "Application"}, supplyChain.Upstream("PhysicalMachine"))
+	assert.Equal(t, []string{"PhysicalMachine", "Storage"}, supplyChain.Downstream("VirtualMachine"))
+	assert.Equal(t, []string{"Storage"}, supplyChain.FilterByType("Storage", "Container").EntityTypes())
+
+	var names []string
+	for _, vm := range supplyChain.EntitiesAbove("s1", "VirtualMachine") {
+		names = append(names, vm.DisplayName)
+	}
+	assert.Equal(t, []string{"web-01", "web-02"}, names)
+	assert.Equal(t, "checkout", supplyChain.EntitiesAbove("s1", "Application")[0].DisplayName)
+
+	hosts := supplyChain.EntitiesBelow("a1", "PhysicalMachine")
+	assert.Equal(t, 1, len(hosts))
+	assert.Equal(t, "esx-01", hosts[0].DisplayName)
+	assert.Empty(t, supplyChain.EntitiesBelow("v3", "Application"))
+}
+
+func TestGetSupplyChainCancelled(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent once the context is cancelled")
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetSupplyChain(ctx, nil, SupplyChainOptions{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
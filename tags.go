@@ -0,0 +1,304 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// How entities are matched by the tags of a tag search
+type TagMatch string
+
+const (
+	TAG_HAS_KEY    TagMatch = "HAS_KEY"
+	TAG_ANY_VALUE  TagMatch = "ANY_VALUE"
+	TAG_ALL_VALUES TagMatch = "ALL_VALUES"
+	TAG_MISSING    TagMatch = "MISSING"
+)
+
+// Parameters for searching entities by tag using Turbonomic's API
+type TagSearchRequest struct {
+	EntityTypes []EntityType
+	Key         string
+	Values      []string
+	// Defaults to TAG_ANY_VALUE when values are provided, and to TAG_HAS_KEY otherwise
+	Match TagMatch
+	// Group or business account the search is restricted to
+	ScopeUuid       string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for removing a single tag key from an entity
+type EntityTagRequest struct {
+	Uuid             string
+	Key              string
+	CommonReqOptions CommonReqParams
+}
+
+// Parameters for tagging a group using Turbonomic's API
+type TagGroupRequest struct {
+	Uuid            string
+	Tags            []Tag
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for removing a single tag key from a group
+type GroupTagRequest struct {
+	Uuid            string
+	Key             string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving the tag keys and values in use
+type TagsRequest struct {
+	// Restricts the catalog to the tags of an entity type, such as VirtualMachine
+	EntityType      string
+	CommonReqParams CommonReqParams
+}
+
+// Removes a tag key from the entity with the provided uuid, returning the
+// remaining tags of the entity
+func (c *Client) DeleteEntityTag(reqOpts EntityTagRequest) ([]Tag, error) {
+	return c.deleteTags("/entities/"+reqOpts.Uuid+"/tags", reqOpts.Key, reqOpts.CommonReqOptions)
+}
+
+// Removes all tags from the entity with the provided uuid, returning the
+// remaining tags of the entity, such as tags discovered from its target
+func (c *Client) DeleteAllEntityTags(reqOpts EntityRequest) ([]Tag, error) {
+	return c.deleteTags("/entities/"+reqOpts.Uuid+"/tags", "", reqOpts.CommonReqOptions)
+}
+
+// Replaces the tags of the entity with the provided uuid, so that its tag set
+// matches the provided tags, returning the resulting tags of the entity
+func (c *Client) SetEntityTags(reqOpts TagEntityRequest) ([]Tag, error) {
+	return c.setTags("/entities/"+reqOpts.Uuid+"/tags", reqOpts.Tags, reqOpts.CommonReqOptions)
+}
+
+// Retrives the tags of the group with the provided uuid
+func (c *Client) GetGroupTags(groupReq GroupRequest) ([]Tag, error) {
+	return c.getTags("/groups/"+groupReq.Uuid+"/tags", groupReq.CommonReqParams)
+}
+
+// Adds tags to the group with the provided uuid
+func (c *Client) TagGroup(tagReq TagGroupRequest) ([]Tag, error) {
+	return c.addTags("/groups/"+tagReq.Uuid+"/tags", tagReq.Tags, tagReq.CommonReqParams)
+}
+
+// Removes a tag key from the group with the provided uuid, returning the
+// remaining tags of the group
+func (c *Client) DeleteGroupTag(tagReq GroupTagRequest) ([]Tag, error) {
+	return c.deleteTags("/groups/"+tagReq.Uuid+"/tags", tagReq.Key, tagReq.CommonReqParams)
+}
+
+// Removes all tags from the group with the provided uuid
+func (c *Client) DeleteAllGroupTags(groupReq GroupRequest) ([]Tag, error) {
+	return c.deleteTags("/groups/"+groupReq.Uuid+"/tags", "", groupReq.CommonReqParams)
+}
+
+// Replaces the tags of the group with the provided uuid, so that its tag set
+// matches the provided tags, returning the resulting tags of the group
+func (c *Client) SetGroupTags(tagReq TagGroupRequest) ([]Tag, error) {
+	return c.setTags("/groups/"+tagReq.Uuid+"/tags", tagReq.Tags, tagReq.CommonReqParams)
+}
+
+// Retrives the tag keys and values in use, optionally for a single entity type
+func (c *Client) GetTags(tagsReq TagsRequest) ([]Tag, error) {
+	return c.getTags("/tags", withQueryParameters(tagsReq.CommonReqParams,
+		map[string]string{"entity_type": tagsReq.EntityType}))
+}
+
+// Retrives the entities of the provided types having, or missing, a tag key
+// and values, with their tags
+func (c *Client) SearchEntitiesByTag(tagReq TagSearchRequest) (SearchResults, error) {
+
+	match := tagReq.Match
+	if match == "" {
+		match = TAG_HAS_KEY
+		if len(tagReq.Values) > 0 {
+			match = TAG_ANY_VALUE
+		}
+	}
+
+	builder := NewSearch(tagReq.EntityTypes...)
+	if tagReq.ScopeUuid != "" {
+		builder.InScope(tagReq.ScopeUuid)
+	}
+	switch match {
+	case TAG_HAS_KEY:
+		builder.WithTagKey(tagReq.Key)
+	case TAG_ANY_VALUE:
+		builder.WithTag(tagReq.Key, tagReq.Values...)
+	case TAG_ALL_VALUES:
+		if len(tagReq.Values) == 0 {
+			return nil, fmt.Errorf("values of tag %s are required", tagReq.Key)
+		}
+		for _, value := range tagReq.Values {
+			builder.WithTag(tagReq.Key, value)
+		}
+	case TAG_MISSING:
+		// Turbonomic cannot match entities missing a tag, so they are
+		// filtered from every page of the entities in scope
+		if tagReq.Key == "" {
+			return nil, errors.New("tag key is required")
+		}
+	default:
+		return nil, fmt.Errorf("tag match %s not supported", match)
+	}
+
+	searches, err := builder.BuildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	results := SearchResults{}
+	for _, search := range searches {
+		found, err := c.searchAllEntities(search, tagReq.CommonReqParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range found {
+			if matchesTag(result.Tags, tagReq.Key, tagReq.Values, match) {
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) getTags(urlPath string, reqParams CommonReqParams) ([]Tag, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: urlPath, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []Tag
+	if err := json.Unmarshal(restResp, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (c *Client) addTags(urlPath string, tags []Tag, reqParams CommonReqParams) ([]Tag, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(tags); err != nil {
+		return nil, err
+	}
+
+	restResp, err := c.request(RequestOptions{Method: "POST", Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var tagsResult []Tag
+	if err := json.Unmarshal(restResp, &tagsResult); err != nil {
+		return nil, err
+	}
+
+	return tagsResult, nil
+}
+
+// Removes the provided tag key, or all tags when the key is empty, and
+// returns the remaining tags
+func (c *Client) deleteTags(urlPath, key string, reqParams CommonReqParams) ([]Tag, error) {
+
+	deletePath := urlPath
+	if key != "" {
+		deletePath += "/" + url.PathEscape(key)
+	}
+
+	if _, err := c.request(RequestOptions{Method: "DELETE", Path: deletePath, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams}); err != nil {
+		return nil, err
+	}
+
+	return c.getTags(urlPath, reqParams)
+}
+
+// Removes the tag keys which are absent from, or have different values than,
+// the provided tags, then adds the missing tags
+func (c *Client) setTags(urlPath string, tags []Tag, reqParams CommonReqParams) ([]Tag, error) {
+
+	current, err := c.getTags(urlPath, reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string][]string, len(tags))
+	for _, tag := range tags {
+		wanted[tag.Key] = tag.Values
+	}
+
+	unchanged := map[string]bool{}
+	for _, tag := range current {
+		if values, ok := wanted[tag.Key]; ok && sameValues(values, tag.Values) {
+			unchanged[tag.Key] = true
+			continue
+		}
+		if _, err := c.request(RequestOptions{Method: "DELETE", Path: urlPath + "/" + url.PathEscape(tag.Key),
+			ReqDTO: new(bytes.Buffer), CommonReqParams: reqParams}); err != nil {
+			return nil, err
+		}
+	}
+
+	var added []Tag
+	for _, tag := range tags {
+		if !unchanged[tag.Key] {
+			added = append(added, tag)
+		}
+	}
+	if len(added) > 0 {
+		if _, err := c.addTags(urlPath, added, reqParams); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.getTags(urlPath, reqParams)
+}
+
+func sameValues(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// Checks the tags of an entity returned by a tag search, as the tag criteria
+// of Turbonomic's API also match values with other keys or partial values
+func matchesTag(tags map[string][]string, key string, values []string, match TagMatch) bool {
+	tagValues, ok := tags[key]
+	switch match {
+	case TAG_HAS_KEY:
+		return ok
+	case TAG_ANY_VALUE:
+		return slices.ContainsFunc(values, func(v string) bool { return slices.Contains(tagValues, v) })
+	case TAG_ALL_VALUES:
+		return !slices.ContainsFunc(values, func(v string) bool { return !slices.Contains(tagValues, v) })
+	default:
+		return !ok
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// Serves the tags of a single entity or group from memory
+func newTagServer(t *testing.T, tagsPath string, tags map[string][]string, requests *[]string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == tagsPath:
+		case r.Method == "POST" && r.URL.Path == tagsPath:
+			var added []Tag
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&added))
+			for _, tag := range added {
+				tags[tag.Key] = append(tags[tag.Key], tag.Values...)
+			}
+		case r.Method == "DELETE" && r.URL.Path == tagsPath:
+			clear(tags)
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, tagsPath+"/"):
+			delete(tags, strings.TrimPrefix(r.URL.Path, tagsPath+"/"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var response []Tag
+		for key, values := range tags {
+			response = append(response, Tag{Key: key, Values: values})
+		}
+		sort.Slice(response, func(i, j int) bool { return response[i].Key < response[j].Key })
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+}
+
+func TestSetEntityTags(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	tags := map[string][]string{
+		"Owner":       {"team-a"},
+		"Environment": {"dev", "test"},
+		"Cost Center": {"1234"},
+	}
+	var requests []string
+	ts := newTagServer(t, "/entities/75941320319680/tags", tags, &requests)
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	result, err := client.SetEntityTags(TagEntityRequest{Uuid: "75941320319680", Tags: []Tag{
+		{Key: "Environment", Values: []string{"test", "dev"}},
+		{Key: "Owner", Values: []string{"team-b"}},
+		{Key: "Tier", Values: []string{"gold"}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Tag{
+		{Key: "Environment", Values: []string{"dev", "test"}},
+		{Key: "Owner", Values: []string{"team-b"}},
+		{Key: "Tier", Values: []string{"gold"}},
+	}, result)
+	assert.Contains(t, requests, "DELETE /entities/75941320319680/tags/Cost Center")
+	assert.Contains(t, requests, "DELETE /entities/75941320319680/tags/Owner")
+	assert.NotContains(t, requests, "DELETE /entities/75941320319680/tags/Environment")
+
+	result, err = client.DeleteEntityTag(EntityTagRequest{Uuid: "75941320319680", Key: "Tier"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(result))
+
+	result, err = client.DeleteAllEntityTags(EntityRequest{Uuid: "75941320319680"})
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestGroupTags(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	tags := map[string][]string{}
+	var requests []string
+	ts := newTagServer(t, "/groups/285024588762240/tags", tags, &requests)
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	result, err := client.TagGroup(TagGroupRequest{Uuid: "285024588762240", Tags: []Tag{{Key: "Owner", Values: []string{"team-a"}}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Tag{{Key: "Owner", Values: []string{"team-a"}}}, result)
+
+	result, err = client.SetGroupTags(TagGroupRequest{Uuid: "285024588762240", Tags: []Tag{{Key: "Owner", Values: []string{"team-b"}}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Tag{{Key: "Owner", Values: []string{"team-b"}}}, result)
+
+	result, err = client.GetGroupTags(GroupRequest{Uuid: "285024588762240"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result))
+
+	result, err = client.DeleteGroupTag(GroupTagRequest{Uuid: "285024588762240", Key: "Owner"})
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = client.DeleteAllGroupTags(GroupRequest{Uuid: "285024588762240"})
+	assert.NoError(t, err)
+}
+
+func TestGetTags(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/tags", r.URL.Path)
+		assert.Equal(t, "VirtualMachine", r.URL.Query().Get("entity_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"key":"Owner","values":["team-a","team-b"]},{"key":"Tier","values":["gold"]}]`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	tags, err := client.GetTags(TagsRequest{EntityType: "VirtualMachine"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, []string{"team-a", "team-b"}, tags[0].Values)
+}
+
+func TestSearchEntitiesByTag(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	var searches []SearchDTO
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/criteria" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/search", r.URL.Path)
+
+		var search struct {
+			SearchDTO
+			Scope []string `json:"scope"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&search))
+		search.Scopes = search.Scope
+
+		response := `[]`
+		if search.ClassName == "VirtualMachine" {
+			// Virtual machines are returned in two pages
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				searches = append(searches, search.SearchDTO)
+				w.Header().Set("X-Next-Cursor", "2")
+				response = `[
+					{"uuid":"1","displayName":"vm-1","className":"VirtualMachine","tags":{"owner":["alice","bob"]}},
+					{"uuid":"2","displayName":"vm-2","className":"VirtualMachine","tags":{"owner":["alice"],"team":["db"]}}
+				]`
+			case "2":
+				response = `[
+					{"uuid":"3","displayName":"vm-3","className":"VirtualMachine","tags":{"owner-backup":["bob"]}},
+					{"uuid":"4","displayName":"vm-4","className":"VirtualMachine"}
+				]`
+			}
+		} else {
+			searches = append(searches, search.SearchDTO)
+			response = `[{"uuid":"5","displayName":"db-1","className":"Database","tags":{"owner":["bob"]}}]`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("failed to write response: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+	client.BaseURL = ts.URL
+
+	tests := []struct {
+		name     string
+		tagReq   TagSearchRequest
+		criteria []Criteria
+		uuids    []string
+	}{
+		{"has key", TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM}, Key: "owner"},
+			[]Criteria{{ExpType: "RXEQ", ExpVal: "owner=.*", FilterType: "vmsByTag"}},
+			[]string{"1", "2"}},
+		{"any value", TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM, EntityTypeDatabase}, Key: "owner", Values: []string{"bob"}},
+			[]Criteria{{ExpType: "EQ", ExpVal: "owner=bob", FilterType: "databaseByTag"}},
+			[]string{"1", "5"}},
+		{"all values", TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM}, Key: "owner", Values: []string{"alice", "bob"}, Match: TAG_ALL_VALUES},
+			[]Criteria{{ExpType: "EQ", ExpVal: "owner=alice", FilterType: "vmsByTag"}, {ExpType: "EQ", ExpVal: "owner=bob", FilterType: "vmsByTag"}},
+			[]string{"1"}},
+		{"missing", TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM}, Key: "owner", Match: TAG_MISSING, ScopeUuid: "123"},
+			[]Criteria{},
+			[]string{"3", "4"}},
+	}
+
+	for _, tt := range tests {
+		searches = nil
+		results, err := client.SearchEntitiesByTag(tt.tagReq)
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.uuids, results.Uuids(), tt.name)
+		if assert.Equal(t, len(tt.tagReq.EntityTypes), len(searches), tt.name) {
+			assert.Equal(t, tt.criteria, searches[len(searches)-1].CriteriaList, tt.name)
+		}
+	}
+	assert.Equal(t, []string{"123"}, searches[0].Scopes)
+
+	results, err := client.SearchEntitiesByTag(TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM}, Key: "team"})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(results)) {
+		assert.Equal(t, map[string][]string{"owner": {"alice"}, "team": {"db"}}, results[0].Tags)
+	}
+
+	_, err = client.SearchEntitiesByTag(TagSearchRequest{EntityTypes: []EntityType{EntityTypeVM}, Key: "owner", Match: TAG_ALL_VALUES})
+	assert.EqualError(t, err, "values of tag owner are required")
+	_, err = client.SearchEntitiesByTag(TagSearchRequest{EntityTypes: []EntityType{EntityTypeStorage}, Key: "owner"})
+	assert.EqualError(t, err, "tag criterion not supported for entity type Storage")
+}
@@ -0,0 +1,309 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Value logged in place of secret input fields
+const redactedValue = "*****"
+
+// Parts of the names of input fields holding secrets, redacted even when
+// the field is not flagged as secret
+var secretFieldNames = []string{"password", "secret", "token", "key", "credential", "passphrase"}
+
+// Defaults used when waiting for a target discovery to complete
+const (
+	DefaultDiscoveryPollInterval = 10 * time.Second
+	DefaultDiscoveryTimeout      = 10 * time.Minute
+)
+
+// Parameters for retriving a target from Turbonomic's API
+type TargetRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving a probe from Turbonomic's API
+type ProbeRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for adding or updating a target using Turbonomic's API
+type TargetInputRequest struct {
+	Uuid            string
+	Target          TargetInput
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for waiting until a target discovery completes
+type WaitForDiscoveryRequest struct {
+	Uuid            string
+	PollInterval    time.Duration
+	Timeout         time.Duration
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API targets
+type TargetInput struct {
+	Category    string       `json:"category,omitempty"`
+	Type        string       `json:"type"`
+	InputFields []InputField `json:"inputFields"`
+}
+
+// Input field of a target, or the specification of one when returned by a probe
+type InputField struct {
+	Name              string   `json:"name"`
+	DisplayName       string   `json:"displayName,omitempty"`
+	Value             string   `json:"value,omitempty"`
+	DefaultValue      string   `json:"defaultValue,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	ValueType         string   `json:"valueType,omitempty"`
+	IsMandatory       bool     `json:"isMandatory,omitempty"`
+	IsSecret          bool     `json:"isSecret,omitempty"`
+	VerificationRegex string   `json:"verificationRegex,omitempty"`
+	AllowedValues     []string `json:"allowedValues,omitempty"`
+}
+
+// Target returned by Turbonomic's API
+type Target struct {
+	UUID          string        `json:"uuid"`
+	DisplayName   string        `json:"displayName"`
+	Category      string        `json:"category"`
+	Type          string        `json:"type"`
+	Status        string        `json:"status"`
+	LastValidated string        `json:"lastValidated,omitempty"`
+	Readonly      bool          `json:"readonly"`
+	InputFields   []InputField  `json:"inputFields"`
+	Health        *TargetHealth `json:"health,omitempty"`
+}
+
+// Health of a target as reported by Turbonomic
+type TargetHealth struct {
+	HealthState        string `json:"healthState"`
+	ErrorType          string `json:"errorType,omitempty"`
+	ErrorText          string `json:"errorText,omitempty"`
+	TimeOfFirstFailure string `json:"timeOfFirstFailure,omitempty"`
+}
+
+// Probe returned by Turbonomic's API, including the input fields its targets accept
+type Probe struct {
+	UUID        string       `json:"uuid"`
+	Type        string       `json:"type"`
+	Category    string       `json:"category"`
+	UICategory  string       `json:"uiCategory,omitempty"`
+	InputFields []InputField `json:"inputFields"`
+}
+
+// Reports whether the target is still being validated or discovered
+func (t Target) InProgress() bool {
+	return strings.Contains(strings.ToLower(t.Status), "in progress")
+}
+
+// Reports whether the last validation or discovery of the target failed
+func (t Target) Failed() bool {
+	return strings.Contains(strings.ToLower(t.Status), "fail")
+}
+
+// Retrives all targets
+func (c *Client) GetTargets(reqParams CommonReqParams) ([]Target, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/targets", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []Target
+	if err := json.Unmarshal(restResp, &targets); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// Retrives a target based on its provided uuid
+func (c *Client) GetTarget(targetReq TargetRequest) (*Target, error) {
+	return c.sendTarget(context.Background(), "GET", "/targets/"+targetReq.Uuid, new(bytes.Buffer), targetReq.CommonReqParams)
+}
+
+// Retrives all probes along with the input fields their targets accept
+func (c *Client) GetProbes(reqParams CommonReqParams) ([]Probe, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/probes", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var probes []Probe
+	if err := json.Unmarshal(restResp, &probes); err != nil {
+		return nil, err
+	}
+
+	return probes, nil
+}
+
+// Retrives a probe based on its provided uuid
+func (c *Client) GetProbe(probeReq ProbeRequest) (*Probe, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/probes/" + probeReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: probeReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var probe Probe
+	if err := json.Unmarshal(restResp, &probe); err != nil {
+		return nil, err
+	}
+
+	return &probe, nil
+}
+
+// Adds a target from the provided input fields
+func (c *Client) AddTarget(targetReq TargetInputRequest) (*Target, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(targetReq.Target); err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, "adding target", "type", targetReq.Target.Type,
+		"inputFields", redactInputFields(targetReq.Target.InputFields))
+
+	return c.sendTarget(context.Background(), "POST", "/targets", dtoBuf, targetReq.CommonReqParams)
+}
+
+// Updates the input fields of the target with the provided uuid
+func (c *Client) UpdateTarget(targetReq TargetInputRequest) (*Target, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(targetReq.Target); err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, "updating target", "uuid", targetReq.Uuid,
+		"inputFields", redactInputFields(targetReq.Target.InputFields))
+
+	return c.sendTarget(context.Background(), "PUT", "/targets/"+targetReq.Uuid, dtoBuf, targetReq.CommonReqParams)
+}
+
+// Triggers validation of the target with the provided uuid
+func (c *Client) ValidateTarget(targetReq TargetRequest) (*Target, error) {
+	return c.sendTarget(context.Background(), "POST", "/targets/"+targetReq.Uuid, new(bytes.Buffer),
+		withQueryParameters(targetReq.CommonReqParams, map[string]string{"validate": "true"}))
+}
+
+// Triggers a new discovery of the target with the provided uuid
+func (c *Client) RediscoverTarget(targetReq TargetRequest) (*Target, error) {
+	return c.sendTarget(context.Background(), "POST", "/targets/"+targetReq.Uuid, new(bytes.Buffer),
+		withQueryParameters(targetReq.CommonReqParams, map[string]string{"rediscover": "true"}))
+}
+
+// Deletes the target with the provided uuid
+func (c *Client) DeleteTarget(targetReq TargetRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/targets/" + targetReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: targetReq.CommonReqParams})
+
+	return err
+}
+
+// Polls the target with the provided uuid until its validation and discovery
+// are no longer in progress, returning an error if they failed or the context
+// is done
+func (c *Client) WaitForDiscovery(ctx context.Context, waitReq WaitForDiscoveryRequest) (*Target, error) {
+
+	interval := waitReq.PollInterval
+	if interval <= 0 {
+		interval = DefaultDiscoveryPollInterval
+	}
+	timeout := waitReq.Timeout
+	if timeout <= 0 {
+		timeout = DefaultDiscoveryTimeout
+	}
+
+	var target *Target
+	err := poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		target, err = c.sendTarget(ctx, "GET", "/targets/"+waitReq.Uuid, new(bytes.Buffer), waitReq.CommonReqParams)
+		if err != nil {
+			return false, err
+		}
+		return !target.InProgress(), nil
+	})
+	if err != nil {
+		return target, fmt.Errorf("waiting for discovery of target %s: %w", waitReq.Uuid, err)
+	}
+	if target.Failed() {
+		return target, fmt.Errorf("discovery of target %s failed: %s", waitReq.Uuid, target.Status)
+	}
+
+	return target, nil
+}
+
+func (c *Client) sendTarget(ctx context.Context, method, urlPath string, dtoBuf *bytes.Buffer, reqParams CommonReqParams) (*Target, error) {
+
+	restResp, err := c.requestWithContext(ctx, RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var target Target
+	if err := json.Unmarshal(restResp, &target); err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, "target response", "uuid", target.UUID, "status", target.Status,
+		"inputFields", redactInputFields(target.InputFields))
+
+	return &target, nil
+}
+
+// Returns a copy of the input fields with the value of secret fields masked,
+// suitable for logging
+func redactInputFields(fields []InputField) []InputField {
+	redacted := make([]InputField, len(fields))
+	for i, field := range fields {
+		if field.Value != "" && isSecretField(field) {
+			field.Value = redactedValue
+		}
+		redacted[i] = field
+	}
+	return redacted
+}
+
+// Reports whether the input field is flagged as secret or is named like a
+// password, secret, token, key or credential
+func isSecretField(field InputField) bool {
+	if field.IsSecret {
+		return true
+	}
+	name := strings.ToLower(field.Name)
+	for _, secretName := range secretFieldNames {
+		if strings.Contains(name, secretName) {
+			return true
+		}
+	}
+	return false
+}
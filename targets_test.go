@@ -0,0 +1,230 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// For Integrations tests, the TargetTests struct is referenced from testdata.go which needs to be created based on testdata.go.template.
+// Integrations tests will only run if the environment variable `INTEGRATION` is set.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// Logger recording every message and its arguments
+type recordingLogger struct {
+	entries []string
+}
+
+func (l *recordingLogger) record(msg string, args ...any) {
+	l.entries = append(l.entries, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *recordingLogger) Info(ctx context.Context, msg string, args ...any)  { l.record(msg, args...) }
+func (l *recordingLogger) Debug(ctx context.Context, msg string, args ...any) { l.record(msg, args...) }
+func (l *recordingLogger) Error(ctx context.Context, msg string, args ...any) { l.record(msg, args...) }
+
+func TestAddTarget(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	logger := &recordingLogger{}
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logger,
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/targets", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"category\":\"Hypervisor\",\"type\":\"vCenter\",\"inputFields\":["+
+			"{\"name\":\"address\",\"value\":\"vc1.turbo.com\"},"+
+			"{\"name\":\"username\",\"value\":\"turbo\"},"+
+			"{\"name\":\"password\",\"value\":\"s3cr3t\",\"isSecret\":true},"+
+			"{\"name\":\"clientSecret\",\"value\":\"t0ps3cr3t\"}]}\n",
+			string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"75941320095008","displayName":"vc1.turbo.com",
+			"category":"Hypervisor","type":"vCenter","status":"Validation in progress",
+			"inputFields":[{"name":"address","value":"vc1.turbo.com"},
+			{"name":"password","value":"s3cr3t","isSecret":true},
+			{"name":"clientSecret","value":"t0ps3cr3t"}]}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	target, err := client.AddTarget(TargetInputRequest{Target: TargetInput{
+		Category: "Hypervisor",
+		Type:     "vCenter",
+		InputFields: []InputField{
+			{Name: "address", Value: "vc1.turbo.com"},
+			{Name: "username", Value: "turbo"},
+			{Name: "password", Value: "s3cr3t", IsSecret: true},
+			// Not flagged as secret, but redacted from its name
+			{Name: "clientSecret", Value: "t0ps3cr3t"},
+		},
+	}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "75941320095008", target.UUID)
+	assert.True(t, target.InProgress())
+	assert.NotEmpty(t, logger.entries)
+	for _, entry := range logger.entries {
+		assert.NotContains(t, entry, "s3cr3t")
+		assert.NotContains(t, entry, "t0ps3cr3t")
+	}
+}
+
+func TestValidateTarget(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/targets/75941320095008", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("validate"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"uuid":"75941320095008","status":"Validated",
+			"lastValidated":"2026-10-18T09:12:44Z","health":{"healthState":"NORMAL"}}`)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	target, err := client.ValidateTarget(TargetRequest{Uuid: "75941320095008"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Validated", target.Status)
+	assert.Equal(t, "2026-10-18T09:12:44Z", target.LastValidated)
+	assert.Equal(t, "NORMAL", target.Health.HealthState)
+}
+
+func TestWaitForDiscovery(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	tests := []struct {
+		name        string
+		statuses    []string
+		expectError bool
+	}{
+		{"discovery succeeds", []string{"Validation in progress", "Discovery in progress", "Validated"}, false},
+		{"discovery fails", []string{"Validation in progress", "Validation failed"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/targets/75941320095008", r.URL.Path)
+				status := tt.statuses[min(calls, len(tt.statuses)-1)]
+				calls++
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				if _, err := fmt.Fprintf(w, `{"uuid":"75941320095008","status":%q}`, status); err != nil {
+					t.Fail()
+					t.Log(err)
+				}
+			}))
+			defer ts.Close()
+
+			client.BaseURL = ts.URL
+
+			target, err := client.WaitForDiscovery(context.Background(), WaitForDiscoveryRequest{
+				Uuid:         "75941320095008",
+				PollInterval: time.Millisecond,
+				Timeout:      time.Second,
+			})
+
+			assert.Equal(t, len(tt.statuses), calls)
+			assert.Equal(t, tt.statuses[len(tt.statuses)-1], target.Status)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTargetsIntegration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("skipping integration tests, to run set environment variable INTEGRATION")
+	}
+	newClientOpts := ClientParameters{Hostname: TurboHost, Username: TurboUser, Password: TurboPass, Skipverify: DoNotVerify}
+
+	c, err := NewClient(&newClientOpts)
+	if err != nil {
+		t.Errorf("failed to create client: %s", err.Error())
+		t.FailNow()
+	}
+
+	for _, tt := range TargetTests {
+		target, err := c.GetTarget(TargetRequest{Uuid: tt.uuid})
+		if err != nil {
+			t.Errorf("error: %s", err.Error())
+			t.FailNow()
+		}
+		if target.DisplayName != tt.displayName || target.Type != tt.targetType {
+			t.Errorf("error: got %s/%s expected %s/%s", target.DisplayName, target.Type, tt.displayName, tt.targetType)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Parameters for retriving or deleting a template from Turbonomic's API
+type TemplateRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for retriving a template by its display name and class, such as
+// VirtualMachineProfile, PhysicalMachineProfile or StorageProfile
+type TemplateByNameRequest struct {
+	DisplayName     string
+	ClassName       string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a template using Turbonomic's API
+type TemplateInputRequest struct {
+	Uuid            string
+	Template        TemplateInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API templates
+type TemplateInput struct {
+	DisplayName             string             `json:"displayName"`
+	ClassName               string             `json:"className"`
+	Description             string             `json:"description,omitempty"`
+	Model                   string             `json:"model,omitempty"`
+	Vendor                  string             `json:"vendor,omitempty"`
+	Price                   float64            `json:"price,omitempty"`
+	ComputeResources        []TemplateResource `json:"computeResources,omitempty"`
+	StorageResources        []TemplateResource `json:"storageResources,omitempty"`
+	InfrastructureResources []TemplateResource `json:"infrastructureResources,omitempty"`
+}
+
+// Template returned by Turbonomic's API
+type Template struct {
+	UUID                    string             `json:"uuid"`
+	DisplayName             string             `json:"displayName"`
+	ClassName               string             `json:"className"`
+	Description             string             `json:"description,omitempty"`
+	Model                   string             `json:"model,omitempty"`
+	Vendor                  string             `json:"vendor,omitempty"`
+	Family                  string             `json:"family,omitempty"`
+	Price                   float64            `json:"price"`
+	Discovered              bool               `json:"discovered"`
+	EnableMatch             bool               `json:"enableMatch"`
+	ComputeResources        []TemplateResource `json:"computeResources,omitempty"`
+	StorageResources        []TemplateResource `json:"storageResources,omitempty"`
+	InfrastructureResources []TemplateResource `json:"infrastructureResources,omitempty"`
+}
+
+// Group of resources provided or consumed by a template
+type TemplateResource struct {
+	Type  string         `json:"type,omitempty"`
+	Stats []TemplateStat `json:"stats"`
+}
+
+// Single resource of a template, such as numOfCpu or memorySize
+type TemplateStat struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Units string  `json:"units,omitempty"`
+}
+
+// Returns the value of the named resource across all resources of the template
+func (t Template) Stat(name string) (float64, bool) {
+	for _, resources := range [][]TemplateResource{t.ComputeResources, t.StorageResources, t.InfrastructureResources} {
+		for _, resource := range resources {
+			for _, stat := range resource.Stats {
+				if stat.Name == name {
+					return stat.Value, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// Builds the input required to update the template with its current values
+func (t Template) Input() TemplateInput {
+	return TemplateInput{
+		DisplayName:             t.DisplayName,
+		ClassName:               t.ClassName,
+		Description:             t.Description,
+		Model:                   t.Model,
+		Vendor:                  t.Vendor,
+		Price:                   t.Price,
+		ComputeResources:        t.ComputeResources,
+		StorageResources:        t.StorageResources,
+		InfrastructureResources: t.InfrastructureResources,
+	}
+}
+
+// Retrives all templates
+func (c *Client) GetTemplates(reqParams CommonReqParams) ([]Template, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/templates", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []Template
+	if err := json.Unmarshal(restResp, &templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Retrives a template based on its provided uuid
+func (c *Client) GetTemplate(templateReq TemplateRequest) (*Template, error) {
+	return c.sendTemplate("GET", "/templates/"+templateReq.Uuid, new(bytes.Buffer), templateReq.CommonReqParams)
+}
+
+// Retrives a template based on its display name and, when provided, its class
+func (c *Client) GetTemplateByName(templateReq TemplateByNameRequest) (*Template, error) {
+
+	templates, err := c.GetTemplates(templateReq.CommonReqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, template := range templates {
+		if template.DisplayName != templateReq.DisplayName {
+			continue
+		}
+		if templateReq.ClassName != "" && template.ClassName != templateReq.ClassName {
+			continue
+		}
+		return &template, nil
+	}
+
+	return nil, fmt.Errorf("template %s not found", templateReq.DisplayName)
+}
+
+// Creates a template
+func (c *Client) CreateTemplate(templateReq TemplateInputRequest) (*Template, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(templateReq.Template); err != nil {
+		return nil, err
+	}
+
+	return c.sendTemplate("POST", "/templates", dtoBuf, templateReq.CommonReqParams)
+}
+
+// Updates the template with the provided uuid
+func (c *Client) UpdateTemplate(templateReq TemplateInputRequest) (*Template, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(templateReq.Template); err != nil {
+		return nil, err
+	}
+
+	return c.sendTemplate("PUT", "/templates/"+templateReq.Uuid, dtoBuf, templateReq.CommonReqParams)
+}
+
+// Deletes the template with the provided uuid
+func (c *Client) DeleteTemplate(templateReq TemplateRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/templates/" + templateReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: templateReq.CommonReqParams})
+
+	return err
+}
+
+func (c *Client) sendTemplate(method, urlPath string, dtoBuf *bytes.Buffer, reqParams CommonReqParams) (*Template, error) {
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var template Template
+	if err := json.Unmarshal(restResp, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTemplateByName(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+	}
+
+	// Mock response from the Turbonomic API
+	mockResponse, err := os.ReadFile("./testfiles/GetTemplates.json")
+	if err != nil {
+		t.Fatal("Error when opening file: ", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/templates", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mockResponse); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	template, err := client.GetTemplateByName(TemplateByNameRequest{DisplayName: "Medium VM", ClassName: "PhysicalMachineProfile"})
+	assert.NoError(t, err)
+	assert.Equal(t, "75941320099999", template.UUID)
+
+	template, err = client.GetTemplateByName(TemplateByNameRequest{DisplayName: "Medium VM"})
+	assert.NoError(t, err)
+	assert.Equal(t, "75941320088888", template.UUID)
+	memory, ok := template.Stat("memorySize")
+	assert.True(t, ok)
+	assert.Equal(t, 8192.0, memory)
+	disk, ok := template.Stat("diskSize")
+	assert.True(t, ok)
+	assert.Equal(t, 100.0, disk)
+	_, ok = template.Stat("numOfCores")
+	assert.False(t, ok)
+
+	_, err = client.GetTemplateByName(TemplateByNameRequest{DisplayName: "Large VM"})
+	assert.Error(t, err)
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	template := Template{
+		UUID:        "75941320088888",
+		DisplayName: "Medium VM",
+		ClassName:   "VirtualMachineProfile",
+		Discovered:  true,
+		ComputeResources: []TemplateResource{{Stats: []TemplateStat{
+			{Name: "numOfCpu", Value: 4},
+			{Name: "memorySize", Value: 8192, Units: "MB"},
+		}}},
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/templates/75941320088888", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"displayName\":\"Medium VM\",\"className\":\"VirtualMachineProfile\","+
+			"\"computeResources\":[{\"stats\":[{\"name\":\"numOfCpu\",\"value\":8},"+
+			"{\"name\":\"memorySize\",\"value\":8192,\"units\":\"MB\"}]}]}\n", string(body))
+
+		var input TemplateInput
+		assert.NoError(t, json.Unmarshal(body, &input))
+		response, _ := json.Marshal(Template{UUID: "75941320088888", DisplayName: input.DisplayName,
+			ClassName: input.ClassName, ComputeResources: input.ComputeResources})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(response); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	input := template.Input()
+	input.ComputeResources[0].Stats[0].Value = 8
+
+	updated, err := client.UpdateTemplate(TemplateInputRequest{Uuid: template.UUID, Template: input})
+	assert.NoError(t, err)
+	cpus, _ := updated.Stat("numOfCpu")
+	assert.Equal(t, 8.0, cpus)
+}
@@ -52,6 +52,19 @@ type TestStats struct {
 	displayName string
 }
 
+type TestGroup struct {
+	uuid         string
+	displayName  string
+	groupType    string
+	membersCount int
+}
+
+type TestTarget struct {
+	uuid        string
+	displayName string
+	targetType  string
+}
+
 var EntityTests = []TestEntity{
 	{},
 	{},
@@ -71,3 +84,11 @@ var ActionTests = []TestAction{
 var StatsTests = []TestStats{
 	{},
 }
+
+var GroupTests = []TestGroup{
+	{},
+}
+
+var TargetTests = []TestTarget{
+	{},
+}
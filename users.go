@@ -0,0 +1,313 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Providers authenticating Turbonomic users
+const (
+	LocalLoginProvider = "Local"
+	LDAPLoginProvider  = "LDAP"
+	SAMLLoginProvider  = "SAML"
+)
+
+// Types of Turbonomic users, shared users having a scoped, shared role
+const (
+	DedicatedUser = "DedicatedCustomer"
+	SharedUser    = "SharedCustomer"
+)
+
+const (
+	oauthClientsPath       = "/authorization/oauth2/clients"
+	oauthRoleScopePrefix   = "role:"
+	clientCredentialsGrant = "client_credentials"
+)
+
+// Parameters for retriving or deleting a user from Turbonomic's API
+type UserRequest struct {
+	Uuid            string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating or updating a user using Turbonomic's API
+type UserInputRequest struct {
+	Uuid            string
+	User            UserInput
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for deleting an OAuth client using Turbonomic's API
+type OAuthClientRequest struct {
+	ClientId        string
+	CommonReqParams CommonReqParams
+}
+
+// Parameters for creating an OAuth client using Turbonomic's API
+type OAuthClientInputRequest struct {
+	Client          OAuthClientInput
+	CommonReqParams CommonReqParams
+}
+
+// Body for POST and PUT requests of Turbonomic API users
+type UserInput struct {
+	Username      string       `json:"username"`
+	Password      string       `json:"password,omitempty"`
+	DisplayName   string       `json:"displayName,omitempty"`
+	LoginProvider string       `json:"loginProvider"`
+	Type          string       `json:"type"`
+	Roles         []UserRole   `json:"roles"`
+	Scope         []BaseApiDTO `json:"scope,omitempty"`
+}
+
+// User returned by Turbonomic's API
+type User struct {
+	UUID          string     `json:"uuid"`
+	Username      string     `json:"username"`
+	DisplayName   string     `json:"displayName,omitempty"`
+	LoginProvider string     `json:"loginProvider"`
+	Type          string     `json:"type"`
+	Roles         []UserRole `json:"roles"`
+	Scope         []Group    `json:"scope,omitempty"`
+}
+
+// Role granted to a user
+type UserRole struct {
+	Name string `json:"name"`
+}
+
+// Body for POST requests of Turbonomic API OAuth clients
+type OAuthClientInput struct {
+	ClientName                  string   `json:"clientName"`
+	GrantTypes                  []string `json:"grantTypes"`
+	ClientAuthenticationMethods []string `json:"clientAuthenticationMethods"`
+	Scopes                      []string `json:"scopes"`
+}
+
+// OAuth client returned by Turbonomic's API. The secret is only returned when
+// the client is created.
+type OAuthClient struct {
+	ClientId                    string   `json:"clientId"`
+	ClientSecret                string   `json:"clientSecret,omitempty"`
+	ClientName                  string   `json:"clientName"`
+	GrantTypes                  []string `json:"grantTypes,omitempty"`
+	ClientAuthenticationMethods []string `json:"clientAuthenticationMethods,omitempty"`
+	Scopes                      []string `json:"scopes,omitempty"`
+}
+
+// Builds the input for a user with a role, scoped to the provided groups when
+// any are given
+func NewUser(username, password, loginProvider string, role TurboRoles, scopeGroupUuids ...string) UserInput {
+	user := UserInput{
+		Username:      username,
+		Password:      password,
+		LoginProvider: loginProvider,
+		Type:          DedicatedUser,
+		Roles:         []UserRole{{Name: role.String()}},
+	}
+	if role == SHARED_ADVISOR || role == SHARED_OBSERVER {
+		user.Type = SharedUser
+	}
+	for _, uuid := range scopeGroupUuids {
+		user.Scope = append(user.Scope, BaseApiDTO{UUID: uuid})
+	}
+	return user
+}
+
+// Builds the input for an OAuth client authenticating with the client
+// credentials grant and the provided role
+func NewOAuthClient(clientName string, role TurboRoles) OAuthClientInput {
+	return OAuthClientInput{
+		ClientName:                  clientName,
+		GrantTypes:                  []string{clientCredentialsGrant},
+		ClientAuthenticationMethods: []string{string(clientSecretPost)},
+		Scopes:                      []string{oauthRoleScopePrefix + role.String()},
+	}
+}
+
+// Returns the role of the user, which is unset for custom roles
+func (u User) Role() TurboRoles {
+	if len(u.Roles) == 0 {
+		return 0
+	}
+	return parseRole(u.Roles[0].Name)
+}
+
+// Builds the input required to update the user with its current values
+func (u User) Input() UserInput {
+	input := UserInput{
+		Username:      u.Username,
+		DisplayName:   u.DisplayName,
+		LoginProvider: u.LoginProvider,
+		Type:          u.Type,
+		Roles:         u.Roles,
+	}
+	for _, group := range u.Scope {
+		input.Scope = append(input.Scope, BaseApiDTO{UUID: group.UUID})
+	}
+	return input
+}
+
+// Returns the credentials to authenticate with the OAuth client, which
+// include its secret only when the client was just created
+func (o OAuthClient) Creds() OAuthCreds {
+	creds := OAuthCreds{ClientId: o.ClientId, ClientSecret: o.ClientSecret}
+	for _, scope := range o.Scopes {
+		if role, ok := strings.CutPrefix(scope, oauthRoleScopePrefix); ok {
+			creds.Role = parseRole(role)
+			break
+		}
+	}
+	return creds
+}
+
+// Retrives all users
+func (c *Client) GetUsers(reqParams CommonReqParams) ([]User, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: "/users", ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := json.Unmarshal(restResp, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Retrives a user based on its provided uuid
+func (c *Client) GetUser(userReq UserRequest) (*User, error) {
+	return c.sendUser("GET", "/users/"+userReq.Uuid, new(bytes.Buffer), userReq.CommonReqParams)
+}
+
+// Retrives the user the client is authenticated as, with its role and scope
+func (c *Client) GetCurrentUser(reqParams CommonReqParams) (*User, error) {
+	return c.sendUser("GET", "/users/me", new(bytes.Buffer), reqParams)
+}
+
+// Creates a local or external user
+func (c *Client) CreateUser(userReq UserInputRequest) (*User, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(userReq.User); err != nil {
+		return nil, err
+	}
+
+	return c.sendUser("POST", "/users", dtoBuf, userReq.CommonReqParams)
+}
+
+// Updates the user with the provided uuid
+func (c *Client) UpdateUser(userReq UserInputRequest) (*User, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(userReq.User); err != nil {
+		return nil, err
+	}
+
+	return c.sendUser("PUT", "/users/"+userReq.Uuid, dtoBuf, userReq.CommonReqParams)
+}
+
+// Deletes the user with the provided uuid
+func (c *Client) DeleteUser(userReq UserRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: "/users/" + userReq.Uuid, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: userReq.CommonReqParams})
+
+	return err
+}
+
+// Retrives all OAuth clients. Their secrets are not returned.
+func (c *Client) GetOAuthClients(reqParams CommonReqParams) ([]OAuthClient, error) {
+
+	restResp, err := c.request(RequestOptions{Method: "GET", Path: oauthClientsPath, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []OAuthClient
+	if err := json.Unmarshal(restResp, &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// Creates an OAuth client, returning its secret which cannot be retrived later
+func (c *Client) CreateOAuthClient(clientReq OAuthClientInputRequest) (*OAuthClient, error) {
+
+	dtoBuf := new(bytes.Buffer)
+	if err := json.NewEncoder(dtoBuf).Encode(clientReq.Client); err != nil {
+		return nil, err
+	}
+
+	// The response holds the client secret, so it is not logged
+	restResp, err := c.request(RequestOptions{Method: "POST", Path: oauthClientsPath, ReqDTO: dtoBuf,
+		CommonReqParams: clientReq.CommonReqParams})
+	if err != nil {
+		return nil, err
+	}
+
+	var client OAuthClient
+	if err := json.Unmarshal(restResp, &client); err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// Deletes the OAuth client with the provided client id
+func (c *Client) DeleteOAuthClient(clientReq OAuthClientRequest) error {
+
+	_, err := c.request(RequestOptions{Method: "DELETE", Path: oauthClientsPath + "/" + clientReq.ClientId, ReqDTO: new(bytes.Buffer),
+		CommonReqParams: clientReq.CommonReqParams})
+
+	return err
+}
+
+func (c *Client) sendUser(method, urlPath string, dtoBuf *bytes.Buffer, reqParams CommonReqParams) (*User, error) {
+
+	restResp, err := c.request(RequestOptions{Method: method, Path: urlPath, ReqDTO: dtoBuf,
+		CommonReqParams: reqParams})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug(c.Ctx, string(restResp))
+
+	var user User
+	if err := json.Unmarshal(restResp, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// Parses a role name returned by Turbonomic's API without panicking on roles
+// unknown to this library
+func parseRole(name string) TurboRoles {
+	for role := ADMINISTRATOR; role <= REPORT_EDITOR; role++ {
+		if strings.EqualFold(role.String(), name) {
+			return role
+		}
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+// Copyright (c) IBM Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS-IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package turboclient
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/turbonomic-go-client/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUser(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logging.NewSlogLogger(),
+		Ctx:    context.Background(),
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var response string
+		switch r.Method + " " + r.URL.Path {
+		case "POST /users":
+			assert.Equal(t, "{\"username\":\"svc-reports\",\"password\":\"s3cret\",\"loginProvider\":\"Local\","+
+				"\"type\":\"SharedCustomer\",\"roles\":[{\"name\":\"SHARED_OBSERVER\"}],"+
+				"\"scope\":[{\"uuid\":\"285024588762240\"}]}\n", string(body))
+			response = `{"uuid":"_4T_7kwY-Ed-WUKbEYSVIDw","username":"svc-reports","loginProvider":"Local",
+				"type":"SharedCustomer","roles":[{"name":"shared_observer"}],
+				"scope":[{"uuid":"285024588762240","displayName":"Production VMs"}]}`
+		case "GET /users/me":
+			response = `{"uuid":"_1","username":"administrator","loginProvider":"Local",
+				"type":"DedicatedCustomer","roles":[{"name":"administrator"}]}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	user, err := client.CreateUser(UserInputRequest{
+		User: NewUser("svc-reports", "s3cret", LocalLoginProvider, SHARED_OBSERVER, "285024588762240"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, SHARED_OBSERVER, user.Role())
+	assert.Equal(t, "Production VMs", user.Scope[0].DisplayName)
+	assert.Equal(t, []BaseApiDTO{{UUID: "285024588762240"}}, user.Input().Scope)
+
+	me, err := client.GetCurrentUser(CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, ADMINISTRATOR, me.Role())
+
+	assert.Equal(t, TurboRoles(0), User{Roles: []UserRole{{Name: "custom_role"}}}.Role())
+}
+
+func TestCreateOAuthClient(t *testing.T) {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	logger := &recordingLogger{}
+	client := &Client{
+		BaseURL: "/api/v3",
+		HTTPClient: &http.Client{
+			Transport: customTransport,
+		},
+		Logger: logger,
+		Ctx:    context.Background(),
+	}
+
+	deleted := false
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var response string
+		switch r.Method + " " + r.URL.Path {
+		case "POST /authorization/oauth2/clients":
+			assert.Equal(t, "{\"clientName\":\"terraform\",\"grantTypes\":[\"client_credentials\"],"+
+				"\"clientAuthenticationMethods\":[\"client_secret_post\"],\"scopes\":[\"role:AUTOMATOR\"]}\n", string(body))
+			response = `{"clientId":"c0ffee","clientSecret":"one-time-secret","clientName":"terraform",
+				"scopes":["role:AUTOMATOR"]}`
+		case "GET /authorization/oauth2/clients":
+			response = `[{"clientId":"c0ffee","clientName":"terraform","scopes":["role:AUTOMATOR"]}]`
+		case "DELETE /authorization/oauth2/clients/c0ffee":
+			deleted = true
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Fail()
+			t.Log(err)
+		}
+	}))
+	defer ts.Close()
+
+	client.BaseURL = ts.URL
+
+	oauthClient, err := client.CreateOAuthClient(OAuthClientInputRequest{Client: NewOAuthClient("terraform", AUTOMATOR)})
+	assert.NoError(t, err)
+	assert.Equal(t, OAuthCreds{ClientId: "c0ffee", ClientSecret: "one-time-secret", Role: AUTOMATOR}, oauthClient.Creds())
+	for _, entry := range logger.entries {
+		assert.NotContains(t, entry, "one-time-secret")
+	}
+
+	clients, err := client.GetOAuthClients(CommonReqParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", clients[0].Creds().ClientSecret)
+
+	assert.NoError(t, client.DeleteOAuthClient(OAuthClientRequest{ClientId: "c0ffee"}))
+	assert.True(t, deleted)
+}